@@ -0,0 +1,182 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/bom"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+)
+
+var (
+	kubeconfig    = flag.String("kubeconfig", "", "Path to a kubeconfig.")
+	masterURL     = flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig.")
+	image         = flag.String("image", "", "The image name to export the bill of materials of")
+	format        = flag.String("format", bom.CycloneDXFormat, "The export format, cyclonedx or spdx")
+	dependency    = flag.String("dependency", "", "The dependency name to find in the latest images")
+	namespace     = flag.String("namespace", "default", "The namespace of the images")
+	allNamespaces = flag.Bool("all-namespaces", false, "Find the dependency in the images of all namespaces")
+)
+
+func main() {
+	flag.Parse()
+
+	clusterConfig, err := BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		log.Fatalf("Error building kubeconfig: %v", err)
+	}
+
+	client, err := versioned.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("could not get kpack client: %s", err.Error())
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("could not get Kubernetes client: %s", err.Error())
+	}
+
+	listNamespace := *namespace
+	if *allNamespaces {
+		listNamespace = metav1.NamespaceAll
+	}
+
+	switch {
+	case *dependency != "":
+		err = find(client, k8sClient, listNamespace, *dependency)
+	case *image != "":
+		err = export(client, k8sClient, *namespace, *image, *format)
+	default:
+		err = fmt.Errorf("either -image or -dependency is required")
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func export(client versioned.Interface, k8sClient kubernetes.Interface, namespace, imageName, format string) error {
+	image, err := client.BuildV1alpha1().Images(namespace).Get(imageName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	builds, err := listBuilds(client, namespace, v1alpha1.ImageLabel+"="+imageName)
+	if err != nil {
+		return err
+	}
+
+	build := bom.LatestBuild(image, builds)
+	if build == nil {
+		return fmt.Errorf("image %s has no successful build", imageName)
+	}
+
+	if err := readFullBOM(k8sClient, build); err != nil {
+		return err
+	}
+
+	document, err := bom.Export(build, format)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Println(string(document))
+	return err
+}
+
+func find(client versioned.Interface, k8sClient kubernetes.Interface, namespace, dependency string) error {
+	imageList, err := client.BuildV1alpha1().Images(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	images := make([]*v1alpha1.Image, 0, len(imageList.Items))
+	for i := range imageList.Items {
+		images = append(images, &imageList.Items[i])
+	}
+
+	builds, err := listBuilds(client, namespace, v1alpha1.ImageLabel)
+	if err != nil {
+		return err
+	}
+
+	var latestBuilds []*v1alpha1.Build
+	for _, image := range images {
+		build := bom.LatestBuild(image, builds)
+		if build == nil {
+			continue
+		}
+
+		if err := readFullBOM(k8sClient, build); err != nil {
+			return err
+		}
+		latestBuilds = append(latestBuilds, build)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAMESPACE\tIMAGE\tVERSION\tBUILDPACK\tLICENSES\tLATEST IMAGE")
+	for _, match := range bom.Find(images, latestBuilds, dependency) {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			match.Namespace,
+			match.Image,
+			match.Entry.Version,
+			match.Entry.Buildpack.ID,
+			strings.Join(match.Entry.Licenses, ","),
+			match.BuiltImage)
+	}
+	return writer.Flush()
+}
+
+// readFullBOM replaces the bounded bill of materials in the build status with
+// the full bill of materials stored in the ConfigMap of the build.
+func readFullBOM(k8sClient kubernetes.Interface, build *v1alpha1.Build) error {
+	if build.Status.BOMConfigMap == "" {
+		return nil
+	}
+
+	configMap, err := k8sClient.CoreV1().ConfigMaps(build.Namespace).Get(build.Status.BOMConfigMap, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	build.Status.BOM, err = v1alpha1.ReadBOMConfigMap(configMap)
+	return err
+}
+
+func listBuilds(client versioned.Interface, namespace, selector string) ([]*v1alpha1.Build, error) {
+	buildList, err := client.BuildV1alpha1().Builds(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+
+	builds := make([]*v1alpha1.Build, 0, len(buildList.Items))
+	for i := range buildList.Items {
+		builds = append(builds, &buildList.Items[i])
+	}
+	return builds, nil
+}
+
+func BuildConfigFromFlags(masterURL, kubeconfigPath string) (*rest.Config, error) {
+	var clientConfigLoader clientcmd.ClientConfigLoader
+
+	if kubeconfigPath == "" {
+		clientConfigLoader = clientcmd.NewDefaultClientConfigLoadingRules()
+	} else {
+		clientConfigLoader = &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath}
+	}
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		clientConfigLoader,
+		&clientcmd.ConfigOverrides{ClusterInfo: api.Cluster{Server: masterURL}}).ClientConfig()
+}
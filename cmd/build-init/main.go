@@ -2,12 +2,15 @@ package main
 
 import (
 	"flag"
+	"io/ioutil"
 	"log"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/pivotal/kpack/pkg/blob"
 	"github.com/pivotal/kpack/pkg/cnb"
@@ -17,14 +20,18 @@ import (
 )
 
 var (
-	platformEnvVars = flag.String("platformEnvVars", os.Getenv("PLATFORM_ENV_VARS"), "a JSON string of build time environment variables formatted as key/value pairs")
-	imageTag        = flag.String("imageTag", os.Getenv("IMAGE_TAG"), "tag of image that will get created by the lifecycle")
+	platformEnvVars     = flag.String("platformEnvVars", os.Getenv("PLATFORM_ENV_VARS"), "a JSON string of build time environment variables formatted as key/value pairs")
+	imageTag            = flag.String("imageTag", os.Getenv("IMAGE_TAG"), "tag of image that will get created by the lifecycle")
+	additionalImageTags = flag.String("additionalImageTags", os.Getenv("ADDITIONAL_IMAGE_TAGS"), "comma separated tags in other repositories the image will be pushed to")
 
 	gitURL        = flag.String("git-url", os.Getenv("GIT_URL"), "The url of the Git repository to initialize.")
 	gitRevision   = flag.String("git-revision", os.Getenv("GIT_REVISION"), "The Git revision to make the repository HEAD.")
 	blobURL       = flag.String("blob-url", os.Getenv("BLOB_URL"), "The url of the source code blob.")
 	registryImage = flag.String("registry-image", os.Getenv("REGISTRY_IMAGE"), "The registry location of the source code image.")
 
+	cacheSizeDir   = flag.String("cache-size-dir", "", "Report the size of the volume build cache in this directory instead of preparing a build.")
+	cacheSizeImage = flag.String("cache-size-image", "", "Report the size of the registry build cache image instead of preparing a build.")
+
 	gitCredentials    credentialsFlags
 	dockerCredentials credentialsFlags
 )
@@ -41,11 +48,18 @@ const (
 	buildSecretsDir       = "/var/build-secrets"
 	imagePullSecretsDir   = "/imagePullSecrets"
 	builderPullSecretsDir = "/builderPullSecrets"
+
+	terminationMessagePath = "/dev/termination-log"
 )
 
 func main() {
 	flag.Parse()
 
+	if *cacheSizeDir != "" || *cacheSizeImage != "" {
+		reportCacheSize(log.New(os.Stdout, "cache-size:", log.Lshortfile))
+		return
+	}
+
 	logger := log.New(os.Stdout, "prepare:", log.Lshortfile)
 
 	creds, err := dockercreds.ParseMountedAnnotatedSecrets(buildSecretsDir, dockerCredentials)
@@ -53,13 +67,15 @@ func main() {
 		log.Fatal(err)
 	}
 
-	hasWriteAccess, err := dockercreds.HasWriteAccess(creds, *imageTag)
-	if err != nil {
-		log.Fatal(err)
-	}
+	for _, tag := range destinationTags() {
+		hasWriteAccess, err := dockercreds.HasWriteAccess(creds, tag)
+		if err != nil {
+			log.Fatal(err)
+		}
 
-	if !hasWriteAccess {
-		log.Fatalf("invalid credentials to build to %s", *imageTag)
+		if !hasWriteAccess {
+			log.Fatalf("invalid credentials to build to %s", tag)
+		}
 	}
 
 	err = fetchSource(logger, creds)
@@ -88,6 +104,13 @@ func main() {
 	}
 }
 
+func destinationTags() []string {
+	if *additionalImageTags == "" {
+		return []string{*imageTag}
+	}
+	return append([]string{*imageTag}, strings.Split(*additionalImageTags, ",")...)
+}
+
 func fetchSource(logger *log.Logger, serviceAccountCreds dockercreds.DockerCreds) error {
 
 	switch {
@@ -122,3 +145,26 @@ func fetchSource(logger *log.Logger, serviceAccountCreds dockercreds.DockerCreds
 		return errors.New("no git url, blob url, or registry image provided")
 	}
 }
+
+// reportCacheSize writes the size of the build cache as the termination message.
+// A cache that cannot be measured does not fail the build.
+func reportCacheSize(logger *log.Logger) {
+	size, err := cacheSize()
+	if err != nil {
+		logger.Printf("unable to measure build cache: %s", err)
+		return
+	}
+
+	quantity := resource.NewQuantity(size, resource.BinarySI)
+	err = ioutil.WriteFile(terminationMessagePath, []byte(quantity.String()), 0644)
+	if err != nil {
+		logger.Printf("unable to report build cache size: %s", err)
+	}
+}
+
+func cacheSize() (int64, error) {
+	if *cacheSizeImage != "" {
+		return cnb.CacheImageSize(*cacheSizeImage, authn.DefaultKeychain)
+	}
+	return cnb.CacheDirSize(*cacheSizeDir)
+}
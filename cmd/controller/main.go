@@ -4,6 +4,7 @@ import (
 	"flag"
 	"log"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -25,8 +26,12 @@ import (
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/build"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/builder"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/clusterbuilder"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/custombuilder"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/image"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/imagepromotion"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/sourceresolver"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/stack"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/store"
 	"github.com/pivotal/kpack/pkg/registry"
 )
 
@@ -40,6 +45,11 @@ var (
 
 	buildInitImage = flag.String("build-init-image", os.Getenv("BUILD_INIT_IMAGE"), "The image used to initialize a build")
 	nopImage       = flag.String("nop-image", os.Getenv("NOP_IMAGE"), "The image used to finish a build")
+	lifecycleImage = flag.String("lifecycle-image", os.Getenv("LIFECYCLE_IMAGE"), "The image providing the lifecycle for custom builders")
+
+	maxRunningBuilds             = flag.Int("max-running-builds", envInt("MAX_RUNNING_BUILDS"), "The number of builds that may run at once, 0 for no limit")
+	maxRunningBuildsPerNamespace = flag.Int("max-running-builds-per-namespace", envInt("MAX_RUNNING_BUILDS_PER_NAMESPACE"), "The number of builds that may run at once in a namespace, 0 for no limit")
+	builderRolloutRate           = flag.Int("builder-rollout-rate", envInt("BUILDER_ROLLOUT_RATE"), "The number of builds caused by builder updates that may start per minute, 0 for no limit")
 )
 
 func main() {
@@ -66,11 +76,12 @@ func main() {
 	}
 
 	options := reconciler.Options{
-		Logger:                  logger,
-		Client:                  client,
-		ResyncPeriod:            10 * time.Hour,
-		SourcePollingFrequency:  1 * time.Minute,
-		BuilderPollingFrequency: 1 * time.Minute,
+		Logger:                     logger,
+		Client:                     client,
+		ResyncPeriod:               10 * time.Hour,
+		SourcePollingFrequency:     1 * time.Minute,
+		BuilderPollingFrequency:    1 * time.Minute,
+		BuildQueuePollingFrequency: 10 * time.Second,
 	}
 
 	informerFactory := externalversions.NewSharedInformerFactory(client, options.ResyncPeriod)
@@ -78,7 +89,11 @@ func main() {
 	imageInformer := informerFactory.Build().V1alpha1().Images()
 	builderInformer := informerFactory.Build().V1alpha1().Builders()
 	clusterBuilderInformer := informerFactory.Build().V1alpha1().ClusterBuilders()
+	customBuilderInformer := informerFactory.Build().V1alpha1().CustomBuilders()
+	stackInformer := informerFactory.Build().V1alpha1().Stacks()
+	storeInformer := informerFactory.Build().V1alpha1().Stores()
 	sourceResolverInformer := informerFactory.Build().V1alpha1().SourceResolvers()
+	imagePromotionInformer := informerFactory.Build().V1alpha1().ImagePromotions()
 
 	k8sInformerFactory := informers.NewSharedInformerFactory(k8sClient, options.ResyncPeriod)
 	pvcInformer := k8sInformerFactory.Core().V1().PersistentVolumeClaims()
@@ -96,10 +111,52 @@ func main() {
 		RemoteImageFactory: imageFactory,
 	}
 
+	builderCreator := &cnb.RemoteBuilderCreator{
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+		LifecycleImage:  *lifecycleImage,
+	}
+
+	stackReader := &cnb.RemoteStackReader{
+		RemoteImageFactory: imageFactory,
+	}
+
+	storeReader := &cnb.RemoteStoreReader{
+		RemoteImageFactory: imageFactory,
+	}
+
+	runImageResolver := &cnb.RemoteRunImageResolver{
+		RemoteImageFactory: imageFactory,
+	}
+
 	rebaser := cnb.ImageRebaser{
 		RemoteImageFactory: imageUtilFactory,
 	}
 
+	imageLabeler := &cnb.ImageLabeler{
+		RemoteImageFactory: imageUtilFactory,
+	}
+
+	imageSigner := &cnb.RemoteImageSigner{
+		K8sClient:       k8sClient,
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+	}
+
+	imageDeleter := &registry.ImageDeleter{
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+	}
+
+	registryAccessChecker := &registry.AccessChecker{
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+	}
+
+	imagePromoter := &registry.ImagePromoter{
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+	}
+
+	indexWriter := &registry.IndexWriter{
+		KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+	}
+
 	buildpodGenerator := &buildpod.Generator{
 		BuildPodConfig: v1alpha1.BuildPodConfig{
 			BuildInitImage: *buildInitImage,
@@ -107,17 +164,30 @@ func main() {
 		},
 		K8sClient:          k8sClient,
 		RemoteImageFactory: imageFactory,
+		PlatformResolver: &registry.PlatformResolver{
+			KeychainFactory: k8sdockercreds.NewSecretKeychainFactory(k8sClient),
+		},
 	}
 
 	gitResolver := git.NewResolver(k8sClient)
 	blobResolver := &blob.Resolver{}
 	registryResolver := &registry.Resolver{}
 
-	buildController := build.NewController(options, k8sClient, buildInformer, podInformer, metadataRetriever, buildpodGenerator, rebaser)
-	imageController := image.NewController(options, k8sClient, imageInformer, buildInformer, builderInformer, clusterBuilderInformer, sourceResolverInformer, pvcInformer)
+	buildLimits := build.Limits{
+		MaxRunning:             *maxRunningBuilds,
+		MaxRunningPerNamespace: *maxRunningBuildsPerNamespace,
+		BuilderRolloutRate:     *builderRolloutRate,
+	}
+
+	buildController := build.NewController(options, k8sClient, buildInformer, podInformer, metadataRetriever, buildpodGenerator, rebaser, imageLabeler, indexWriter, imageSigner, imagePromoter, buildLimits)
+	imageController := image.NewController(options, k8sClient, imageInformer, buildInformer, builderInformer, clusterBuilderInformer, customBuilderInformer, sourceResolverInformer, stackInformer, imagePromotionInformer, pvcInformer, runImageResolver, imageDeleter, registryAccessChecker)
 	builderController := builder.NewController(options, builderInformer, metadataRetriever)
 	clusterBuilderController := clusterbuilder.NewController(options, clusterBuilderInformer, metadataRetriever)
+	customBuilderController := custombuilder.NewController(options, customBuilderInformer, builderCreator, metadataRetriever)
+	stackController := stack.NewController(options, stackInformer, stackReader)
+	storeController := store.NewController(options, storeInformer, storeReader)
 	sourceResolverController := sourceresolver.NewController(options, sourceResolverInformer, gitResolver, blobResolver, registryResolver)
+	imagePromotionController := imagepromotion.NewController(options, imagePromotionInformer, imageInformer, buildInformer, imagePromoter)
 
 	stopChan := make(chan struct{})
 	informerFactory.Start(stopChan)
@@ -127,7 +197,11 @@ func main() {
 	cache.WaitForCacheSync(stopChan, imageInformer.Informer().HasSynced)
 	cache.WaitForCacheSync(stopChan, builderInformer.Informer().HasSynced)
 	cache.WaitForCacheSync(stopChan, clusterBuilderInformer.Informer().HasSynced)
+	cache.WaitForCacheSync(stopChan, customBuilderInformer.Informer().HasSynced)
+	cache.WaitForCacheSync(stopChan, stackInformer.Informer().HasSynced)
+	cache.WaitForCacheSync(stopChan, storeInformer.Informer().HasSynced)
 	cache.WaitForCacheSync(stopChan, sourceResolverInformer.Informer().HasSynced)
+	cache.WaitForCacheSync(stopChan, imagePromotionInformer.Informer().HasSynced)
 	cache.WaitForCacheSync(stopChan, pvcInformer.Informer().HasSynced)
 	cache.WaitForCacheSync(stopChan, podInformer.Informer().HasSynced)
 
@@ -144,15 +218,40 @@ func main() {
 		func(done <-chan struct{}) error {
 			return clusterBuilderController.Run(routinesPerController, done)
 		},
+		func(done <-chan struct{}) error {
+			return customBuilderController.Run(routinesPerController, done)
+		},
+		func(done <-chan struct{}) error {
+			return stackController.Run(routinesPerController, done)
+		},
+		func(done <-chan struct{}) error {
+			return storeController.Run(routinesPerController, done)
+		},
 		func(done <-chan struct{}) error {
 			return sourceResolverController.Run(2*routinesPerController, done)
 		},
+		func(done <-chan struct{}) error {
+			return imagePromotionController.Run(routinesPerController, done)
+		},
 	)
 	if err != nil {
 		logger.Fatalw("Error running controller", zap.Error(err))
 	}
 }
 
+func envInt(key string) int {
+	env := os.Getenv(key)
+	if env == "" {
+		return 0
+	}
+
+	value, err := strconv.Atoi(env)
+	if err != nil || value < 0 {
+		log.Fatalf("invalid %s %q: must be a non-negative integer", key, env)
+	}
+	return value
+}
+
 type doneFunc func(done <-chan struct{}) error
 
 func runGroup(fns ...doneFunc) error {
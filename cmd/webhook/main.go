@@ -0,0 +1,193 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"go.uber.org/zap"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/webhook"
+
+	"github.com/pivotal/kpack/pkg/apis/build"
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/apis/config"
+	"github.com/pivotal/kpack/pkg/conversion"
+)
+
+const (
+	webhookName    = "resource.webhook.kpack.pivotal.io"
+	serviceName    = "kpack-webhook"
+	deploymentName = "kpack-webhook"
+	secretName     = "webhook-certs"
+	webhookPort    = 8443
+	conversionPort = 8444
+	conversionPath = "/convert"
+)
+
+var (
+	kubeconfig = flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
+	masterURL  = flag.String("master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
+
+	namespace = flag.String("namespace", os.Getenv("SYSTEM_NAMESPACE"), "The namespace the webhook is deployed in")
+)
+
+func main() {
+	flag.Parse()
+	devLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("Couldn't create logger: %s", err)
+	}
+	logger := devLogger.Sugar()
+
+	clusterConfig, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfig)
+	if err != nil {
+		logger.Fatalf("Error building kubeconfig: %v", err)
+	}
+
+	k8sClient, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("could not get kubernetes client: %s", err.Error())
+	}
+
+	extensionsClient, err := apiextensionsclientset.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("could not get apiextensions client: %s", err.Error())
+	}
+
+	stopChan := signals.SetupSignalHandler()
+
+	configStore := config.NewStore(logger.Named("config-store"))
+	configMapWatcher := configmap.NewInformedWatcher(k8sClient, *namespace)
+	configStore.WatchConfigs(configMapWatcher)
+	if err := configMapWatcher.Start(stopChan); err != nil {
+		logger.Fatalw("Failed to start configuration manager", zap.Error(err))
+	}
+
+	options := webhook.ControllerOptions{
+		ResourceMutatingWebhookName:     webhookName,
+		ResourceAdmissionControllerPath: "/",
+		ServiceName:                     serviceName,
+		DeploymentName:                  deploymentName,
+		SecretName:                      secretName,
+		Namespace:                       *namespace,
+		Port:                            webhookPort,
+	}
+
+	admissionControllers := map[string]webhook.AdmissionController{
+		options.ResourceAdmissionControllerPath: webhook.NewResourceAdmissionController(handlers(), options, true),
+	}
+
+	admissionWebhook, err := webhook.New(k8sClient, options, admissionControllers, logger, configStore.ToContext)
+	if err != nil {
+		logger.Fatalw("Error creating admission webhook", zap.Error(err))
+	}
+
+	conversionWebhook := &conversion.Webhook{
+		Client:           k8sClient,
+		ExtensionsClient: extensionsClient,
+		Converter: &conversion.Converter{
+			Logger:     logger,
+			Group:      build.GroupName,
+			HubVersion: v1alpha1.SchemeGroupVersion.Version,
+			Kinds:      conversionKinds(),
+		},
+		Logger:      logger,
+		Namespace:   *namespace,
+		ServiceName: serviceName,
+		SecretName:  secretName,
+		Path:        conversionPath,
+		Port:        conversionPort,
+		CRDs: []string{
+			"builds.build.pivotal.io",
+			"images.build.pivotal.io",
+			"builders.build.pivotal.io",
+			"clusterbuilders.build.pivotal.io",
+			"custombuilders.build.pivotal.io",
+			"sourceresolvers.build.pivotal.io",
+			"stacks.build.pivotal.io",
+			"stores.build.pivotal.io",
+			"imagepromotions.build.pivotal.io",
+		},
+	}
+
+	go func() {
+		if err := conversionWebhook.Run(stopChan); err != nil {
+			logger.Fatalw("Error running conversion webhook", zap.Error(err))
+		}
+	}()
+
+	if err := admissionWebhook.Run(stopChan); err != nil {
+		logger.Fatalw("Error running admission webhook", zap.Error(err))
+	}
+}
+
+func handlers() map[schema.GroupVersionKind]webhook.GenericCRD {
+	return map[schema.GroupVersionKind]webhook.GenericCRD{
+		v1alpha1.SchemeGroupVersion.WithKind("Image"):                     &v1alpha1.Image{},
+		v1alpha1.SchemeGroupVersion.WithKind("Build"):                     &v1alpha1.Build{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.BuilderKind):        &v1alpha1.Builder{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ClusterBuilderKind): &v1alpha1.ClusterBuilder{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.CustomBuilderKind):  &v1alpha1.CustomBuilder{},
+		v1alpha1.SchemeGroupVersion.WithKind("SourceResolver"):            &v1alpha1.SourceResolver{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.StackKind):          &v1alpha1.Stack{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.StoreKind):          &v1alpha1.Store{},
+		v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ImagePromotionKind): &v1alpha1.ImagePromotion{},
+		v1alpha2.SchemeGroupVersion.WithKind("Image"):                     &v1alpha2.Image{},
+		v1alpha2.SchemeGroupVersion.WithKind("Build"):                     &v1alpha2.Build{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.BuilderKind):        &v1alpha2.Builder{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterBuilderKind): &v1alpha2.ClusterBuilder{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.CustomBuilderKind):  &v1alpha2.CustomBuilder{},
+		v1alpha2.SchemeGroupVersion.WithKind("SourceResolver"):            &v1alpha2.SourceResolver{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.StackKind):          &v1alpha2.Stack{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.StoreKind):          &v1alpha2.Store{},
+		v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ImagePromotionKind): &v1alpha2.ImagePromotion{},
+	}
+}
+
+func conversionKinds() map[string]conversion.Kind {
+	return map[string]conversion.Kind{
+		"Image": {
+			Hub:    &v1alpha1.Image{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.Image{}},
+		},
+		"Build": {
+			Hub:    &v1alpha1.Build{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.Build{}},
+		},
+		v1alpha1.BuilderKind: {
+			Hub:    &v1alpha1.Builder{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.Builder{}},
+		},
+		v1alpha1.ClusterBuilderKind: {
+			Hub:    &v1alpha1.ClusterBuilder{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.ClusterBuilder{}},
+		},
+		v1alpha1.CustomBuilderKind: {
+			Hub:    &v1alpha1.CustomBuilder{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.CustomBuilder{}},
+		},
+		"SourceResolver": {
+			Hub:    &v1alpha1.SourceResolver{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.SourceResolver{}},
+		},
+		v1alpha1.StackKind: {
+			Hub:    &v1alpha1.Stack{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.Stack{}},
+		},
+		v1alpha1.StoreKind: {
+			Hub:    &v1alpha1.Store{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.Store{}},
+		},
+		v1alpha1.ImagePromotionKind: {
+			Hub:    &v1alpha1.ImagePromotion{},
+			Spokes: map[string]conversion.Convertible{v1alpha2.SchemeGroupVersion.Version: &v1alpha2.ImagePromotion{}},
+		},
+	}
+}
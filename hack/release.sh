@@ -25,6 +25,7 @@ IMAGE_PREFIX=${IMAGE_PREFIX:-"${registry}/"}
 
 controller_image=${IMAGE_PREFIX}controller
 build_init_image=${IMAGE_PREFIX}build-init
+webhook_image=${IMAGE_PREFIX}webhook
 
 pack_build ${controller_image} "./cmd/controller"
 controller_image=${resolved_image_name}
@@ -32,6 +33,9 @@ controller_image=${resolved_image_name}
 pack_build ${build_init_image} "./cmd/build-init"
 build_init_image=${resolved_image_name}
 
+pack_build ${webhook_image} "./cmd/webhook"
+webhook_image=${resolved_image_name}
+
 nop_image=gcr.io/pivotal-knative/github.com/knative/build/cmd/nop@sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4
 
-ytt -f config/. -v controller_image=${controller_image} -v build_init_image=${build_init_image} -v nop_image=${nop_image} > ${release_yaml}
\ No newline at end of file
+ytt -f config/. -v controller_image=${controller_image} -v webhook_image=${webhook_image} -v build_init_image=${build_init_image} -v nop_image=${nop_image} > ${release_yaml}
\ No newline at end of file
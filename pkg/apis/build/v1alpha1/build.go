@@ -1,30 +1,36 @@
 package v1alpha1
 
 import (
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 	"knative.dev/pkg/kmeta"
 )
 
+const (
+	BuildStepFailed = "BuildStepFailed"
+	BuildPodFailed  = "BuildPodFailed"
+
+	// BuildConditionPending is true while a build waits for a build limit
+	// before its pod is created.
+	BuildConditionPending duckv1alpha1.ConditionType = "Pending"
+
+	// BuildConditionSigned reports whether the image of a succeeded build
+	// with a signing secret has been signed. Failures to sign are retried
+	// without failing the build.
+	BuildConditionSigned duckv1alpha1.ConditionType = "Signed"
+
+	// BuildConditionMirrored reports whether the image of a succeeded build
+	// has been copied to its tags in other registries than the build tag.
+	// Failures to copy are retried without failing the build.
+	BuildConditionMirrored duckv1alpha1.ConditionType = "Mirrored"
+)
+
 func (bi *BuildBuilderSpec) getBuilderSecretVolume() corev1.Volume {
-	if len(bi.ImagePullSecrets) > 0 {
-		return corev1.Volume{
-			Name: builderPullSecretsDirName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: bi.ImagePullSecrets[0].Name,
-				},
-			},
-		}
-	} else {
-		return corev1.Volume{
-			Name: builderPullSecretsDirName,
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
-		}
-	}
+	return pullSecretsVolume(builderPullSecretsDirName, bi.ImagePullSecrets)
 }
 
 func (*Build) GetGroupVersionKind() schema.GroupVersionKind {
@@ -35,6 +41,84 @@ func (b *Build) Tag() string {
 	return b.Spec.Tags[0]
 }
 
+// DestinationTags returns the first tag of every repository the build is
+// pushed to, starting with the repository of the build tag.
+func (b *Build) DestinationTags() []string {
+	return destinationTags(b.Spec.Tags)
+}
+
+// ExportTags returns the tags of the build in the registry of the build tag.
+// The lifecycle writes an image to a single registry, so the image is copied
+// to the MirrorTags once the build succeeds.
+func (b *Build) ExportTags() []string {
+	var tags []string
+	for _, tag := range b.Spec.Tags {
+		if sameRegistry(b.Tag(), tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// MirrorTags returns the tags of the build in other registries than the
+// registry of the build tag.
+func (b *Build) MirrorTags() []string {
+	var tags []string
+	for _, tag := range b.Spec.Tags {
+		if !sameRegistry(b.Tag(), tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func sameRegistry(tag, other string) bool {
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return true
+	}
+
+	otherRef, err := name.NewTag(other, name.WeakValidation)
+	if err != nil {
+		return true
+	}
+	return ref.RegistryStr() == otherRef.RegistryStr()
+}
+
+func destinationTags(buildTags []string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, tag := range buildTags {
+		repository := tag
+		if ref, err := name.NewTag(tag, name.WeakValidation); err == nil {
+			repository = ref.Context().Name()
+		}
+
+		if !seen[repository] {
+			tags = append(tags, tag)
+		}
+		seen[repository] = true
+	}
+	return tags
+}
+
+func (b *Build) hasTags(tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, buildTag := range b.Spec.Tags {
+			if buildTag == tag {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (b *Build) IsRunning() bool {
 	if b == nil {
 		return false
@@ -97,6 +181,28 @@ func (b *Build) ImagePullSecretsVolume() corev1.Volume {
 	return b.Spec.Source.Source().ImagePullSecretsVolume()
 }
 
+// Rebasable reports whether the build only has to rebase the last built image.
+// The image index of a multi-platform build cannot be rebased.
 func (b *Build) Rebasable() bool {
-	return b.Annotations[BuildReasonAnnotation] == BuildReasonStack
+	return !b.MultiPlatform() && b.Annotations[BuildReasonAnnotation] == BuildReasonStack
+}
+
+// BuilderTriggered reports whether the build was caused only by an update of
+// its builder.
+func (b *Build) BuilderTriggered() bool {
+	reasons := b.Annotations[BuildReasonAnnotation]
+	if reasons == "" {
+		return false
+	}
+
+	for _, reason := range strings.Split(reasons, ",") {
+		if reason != BuildReasonBuildpack && reason != BuildReasonStack {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Build) IsPending() bool {
+	return b.Status.GetCondition(BuildConditionPending).IsTrue()
 }
@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// BOMConfigMapKey is the key of the full bill of materials of a build in
+	// its bill of materials ConfigMap.
+	BOMConfigMapKey = "bom.json"
+
+	// MaxStatusBOMEntries bounds the bill of materials listed in the build
+	// status. Images can contain thousands of dependencies, which would exceed
+	// the size limit of the build resource.
+	MaxStatusBOMEntries = 100
+)
+
+func (b *Build) BOMConfigMapName() string {
+	return kmeta.ChildName(b.Name, "-bom")
+}
+
+// BOMConfigMap is the ConfigMap holding the full bill of materials of the
+// build. It is owned by the build and removed with it.
+func (b *Build) BOMConfigMap(bom []BOMEntry) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(bom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.BOMConfigMapName(),
+			Namespace: b.Namespace,
+			Labels:    b.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(b),
+			},
+		},
+		Data: map[string]string{
+			BOMConfigMapKey: string(data),
+		},
+	}, nil
+}
+
+// StatusBOM is the part of the bill of materials listed in the build status.
+func StatusBOM(bom []BOMEntry) []BOMEntry {
+	if len(bom) > MaxStatusBOMEntries {
+		return bom[:MaxStatusBOMEntries]
+	}
+	return bom
+}
+
+// ReadBOMConfigMap reads the full bill of materials of a build from its
+// bill of materials ConfigMap.
+func ReadBOMConfigMap(configMap *corev1.ConfigMap) ([]BOMEntry, error) {
+	var bom []BOMEntry
+	if err := json.Unmarshal([]byte(configMap.Data[BOMConfigMapKey]), &bom); err != nil {
+		return nil, err
+	}
+	return bom, nil
+}
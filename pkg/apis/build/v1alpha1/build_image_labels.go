@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"time"
+)
+
+const (
+	OCISourceLabel   = "org.opencontainers.image.source"
+	OCIRevisionLabel = "org.opencontainers.image.revision"
+	OCICreatedLabel  = "org.opencontainers.image.created"
+	OCIVersionLabel  = "org.opencontainers.image.version"
+)
+
+// ImageLabels returns the labels applied to the built image. The standard OCI
+// labels describe the source and the build, labels from the spec take
+// precedence over them.
+func (b *Build) ImageLabels() map[string]string {
+	labels := map[string]string{}
+
+	switch {
+	case b.Spec.Source.Git != nil:
+		labels[OCISourceLabel] = b.Spec.Source.Git.URL
+		labels[OCIRevisionLabel] = b.Spec.Source.Git.Revision
+	case b.Spec.Source.Blob != nil:
+		labels[OCISourceLabel] = b.Spec.Source.Blob.URL
+	case b.Spec.Source.Registry != nil:
+		labels[OCISourceLabel] = b.Spec.Source.Registry.Image
+	}
+
+	if b.Status.CompletionTime != nil {
+		labels[OCICreatedLabel] = b.Status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+
+	if buildNumber, ok := b.Labels[BuildNumberLabel]; ok {
+		labels[OCIVersionLabel] = buildNumber
+	}
+
+	for k, v := range b.Spec.ImageLabels {
+		labels[k] = v
+	}
+	return labels
+}
@@ -0,0 +1,80 @@
+package v1alpha1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestBuildImageLabels(t *testing.T) {
+	spec.Run(t, "Build Image Labels", testBuildImageLabels)
+}
+
+func testBuildImageLabels(t *testing.T, when spec.G, it spec.S) {
+	completionTime := metav1.NewTime(time.Date(2019, 10, 21, 10, 5, 0, 0, time.UTC))
+
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-build",
+			Labels: map[string]string{
+				v1alpha1.BuildNumberLabel: "12",
+			},
+		},
+		Spec: v1alpha1.BuildSpec{
+			Source: v1alpha1.SourceConfig{
+				Git: &v1alpha1.Git{
+					URL:      "https://github.com/some/app",
+					Revision: "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f",
+				},
+			},
+		},
+		Status: v1alpha1.BuildStatus{
+			CompletionTime: &completionTime,
+		},
+	}
+
+	when("#ImageLabels", func() {
+		it("describes the git source and the build with the standard labels", func() {
+			assert.Equal(t, map[string]string{
+				"org.opencontainers.image.source":   "https://github.com/some/app",
+				"org.opencontainers.image.revision": "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f",
+				"org.opencontainers.image.created":  "2019-10-21T10:05:00Z",
+				"org.opencontainers.image.version":  "12",
+			}, build.ImageLabels())
+		})
+
+		it("uses the blob url as the source", func() {
+			build.Spec.Source = v1alpha1.SourceConfig{
+				Blob: &v1alpha1.Blob{URL: "https://some.place/app.jar"},
+			}
+
+			labels := build.ImageLabels()
+			assert.Equal(t, "https://some.place/app.jar", labels[v1alpha1.OCISourceLabel])
+			assert.NotContains(t, labels, v1alpha1.OCIRevisionLabel)
+		})
+
+		it("uses the registry image as the source", func() {
+			build.Spec.Source = v1alpha1.SourceConfig{
+				Registry: &v1alpha1.Registry{Image: "some.registry.io/source"},
+			}
+
+			assert.Equal(t, "some.registry.io/source", build.ImageLabels()[v1alpha1.OCISourceLabel])
+		})
+
+		it("adds the labels of the spec and lets them override the standard labels", func() {
+			build.Spec.ImageLabels = map[string]string{
+				"org.opencontainers.image.version": "1.2.3",
+				"com.example.team":                 "payments",
+			}
+
+			labels := build.ImageLabels()
+			assert.Equal(t, "1.2.3", labels[v1alpha1.OCIVersionLabel])
+			assert.Equal(t, "payments", labels["com.example.team"])
+		})
+	})
+}
@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	BuildPlatformLabel = "build.pivotal.io/platform"
+
+	nodeOSLabel   = "kubernetes.io/os"
+	nodeArchLabel = "kubernetes.io/arch"
+)
+
+// ParsePlatform splits a platform of the form os/arch or os/arch/variant.
+func ParsePlatform(platform string) (os, arch, variant string, err error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return "", "", "", fmt.Errorf("platform %s is not of the form os/arch[/variant]", platform)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return "", "", "", fmt.Errorf("platform %s is not of the form os/arch[/variant]", platform)
+		}
+	}
+
+	if len(parts) == 3 {
+		variant = parts[2]
+	}
+	return parts[0], parts[1], variant, nil
+}
+
+// MultiPlatform reports whether the build runs a pod per platform and pushes
+// an image index to its tags.
+func (b *Build) MultiPlatform() bool {
+	return len(b.Spec.Platforms) > 0
+}
+
+func (b *Build) PlatformPodName(platform string) string {
+	return kmeta.ChildName(b.Name, "-build-pod-"+platformSuffix(platform))
+}
+
+// PlatformTag is the tag the image of a single platform is exported to before
+// it is added to the image index.
+func (b *Build) PlatformTag(platform string) string {
+	return platformTag(b.Tag(), platform)
+}
+
+// PlatformStatus returns the recorded status of the platform or an empty
+// status if its pod has not been created yet.
+func (b *Build) PlatformStatus(platform string) BuildPlatformStatus {
+	for _, status := range b.Status.Platforms {
+		if status.Platform == platform {
+			return status
+		}
+	}
+	return BuildPlatformStatus{Platform: platform}
+}
+
+// PlatformBuild is the single platform build run by the pod of the platform.
+// It exports to the platform tag and keeps a separate registry cache per
+// platform. Volume caches cannot be shared by pods on different nodes.
+func (b *Build) PlatformBuild(platform string) *Build {
+	build := b.DeepCopy()
+	build.Spec.Tags = []string{b.PlatformTag(platform)}
+	build.Spec.Platforms = nil
+	build.Spec.CacheName = ""
+	if b.Spec.CacheImage != "" {
+		build.Spec.CacheImage = platformTag(b.Spec.CacheImage, platform)
+	}
+	build.Status.LatestImage = b.PlatformStatus(platform).LatestImage
+	build.Status.Platforms = nil
+	return build
+}
+
+// PlatformBuildPod is the pod building the image of the platform on a node
+// of that platform.
+func (b *Build) PlatformBuildPod(platform string, config BuildPodConfig, secrets []corev1.Secret, builder BuildBuilderSpec, builderConfig BuildPodBuilderConfig) (*corev1.Pod, error) {
+	os, arch, _, err := ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := b.PlatformBuild(platform).BuildPod(config, secrets, builder, builderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	pod.Name = b.PlatformPodName(platform)
+	pod.Labels[BuildPlatformLabel] = platformSuffix(platform)
+	pod.Spec.NodeSelector = map[string]string{
+		nodeOSLabel:   os,
+		nodeArchLabel: arch,
+	}
+	return pod, nil
+}
+
+func platformTag(tag, platform string) string {
+	ref, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return tag + "-" + platformSuffix(platform)
+	}
+	return strings.TrimSuffix(tag, ":"+ref.TagStr()) + ":" + ref.TagStr() + "-" + platformSuffix(platform)
+}
+
+func platformSuffix(platform string) string {
+	return strings.Replace(platform, "/", "-", -1)
+}
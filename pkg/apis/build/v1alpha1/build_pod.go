@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,7 +20,10 @@ const (
 	DOCKERSecretAnnotationPrefix = "build.pivotal.io/docker"
 	GITSecretAnnotationPrefix    = "build.pivotal.io/git"
 
+	CacheSizeContainerName = "cache-size"
+
 	cacheDirName              = "cache-dir"
+	emptyCacheDirName         = "empty-cache-dir"
 	layersDirName             = "layers-dir"
 	platformDir               = "platform-dir"
 	homeDir                   = "home-dir"
@@ -33,9 +37,12 @@ type BuildPodConfig struct {
 	NopImage       string
 }
 
-type UserAndGroup struct {
-	Uid int64
-	Gid int64
+// BuildPodBuilderConfig is the configuration read from the builder image.
+// RunImage is the run image, or the mirror of it, the app image is exported on.
+type BuildPodBuilderConfig struct {
+	Uid      int64
+	Gid      int64
+	RunImage string
 }
 
 var (
@@ -55,6 +62,10 @@ var (
 		Name:      cacheDirName,
 		MountPath: "/cache",
 	}
+	emptyCacheVolume = corev1.VolumeMount{
+		Name:      emptyCacheDirName,
+		MountPath: "/cache",
+	}
 	layersVolume = corev1.VolumeMount{
 		Name:      layersDirName,
 		MountPath: "/layers",
@@ -75,7 +86,7 @@ var (
 	}
 )
 
-func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder BuildBuilderSpec, userAndGroup UserAndGroup) (*corev1.Pod, error) {
+func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder BuildBuilderSpec, builderConfig BuildPodBuilderConfig) (*corev1.Pod, error) {
 	buf, err := json.Marshal(b.Spec.Env)
 	if err != nil {
 		return nil, err
@@ -97,7 +108,7 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 		SubPath:   b.Spec.Source.SubPath, // empty string is a nop
 	}
 
-	return &corev1.Pod{
+	pod := &corev1.Pod{
 		ObjectMeta: v1.ObjectMeta{
 			Name:      b.PodName(),
 			Namespace: b.Namespace,
@@ -120,27 +131,26 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 				},
 			},
 			SecurityContext: &corev1.PodSecurityContext{
-				FSGroup: &userAndGroup.Gid,
+				FSGroup: &builderConfig.Gid,
 			},
 			InitContainers: []corev1.Container{
 				{
 					Name:  "prepare",
 					Image: config.BuildInitImage,
 					SecurityContext: &corev1.SecurityContext{
-						RunAsUser:  &userAndGroup.Uid,
-						RunAsGroup: &userAndGroup.Gid,
+						RunAsUser:  &builderConfig.Uid,
+						RunAsGroup: &builderConfig.Gid,
 					},
 					Args: buildInitArgs(buildInitBinary, secretArgs),
 					Env: append(
-						b.SourceEnvVars(),
-						corev1.EnvVar{
-							Name:  "PLATFORM_ENV_VARS",
-							Value: envVars,
-						},
-						corev1.EnvVar{
-							Name:  "IMAGE_TAG",
-							Value: b.Tag(),
-						},
+						append(
+							b.SourceEnvVars(),
+							corev1.EnvVar{
+								Name:  "PLATFORM_ENV_VARS",
+								Value: envVars,
+							},
+						),
+						b.imageTagEnvVars()...,
 					),
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					WorkingDir:      "/workspace",
@@ -167,22 +177,18 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 						platformVolume,
 						workspaceVolume,
 					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 				{
-					Name:    "restore",
-					Image:   builderImage,
-					Command: []string{"/lifecycle/restorer"},
-					Args: []string{
-						"-group=/layers/group.toml",
-						"-layers=/layers",
-						"-path=/cache",
-					},
-					VolumeMounts: []corev1.VolumeMount{
-						layersVolume,
-						cacheVolume,
-					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					Name:                     "restore",
+					Image:                    builderImage,
+					Command:                  []string{"/lifecycle/restorer"},
+					Args:                     b.cacheArgs(b.Spec.ClearCache),
+					VolumeMounts:             b.cacheVolumeMounts(b.Spec.ClearCache),
+					Env:                      b.cacheEnv(b.Spec.ClearCache),
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 				{
 					Name:    "analyze",
@@ -203,7 +209,8 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 					Env: []corev1.EnvVar{
 						homeEnv,
 					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 				{
 					Name:    "build",
@@ -220,19 +227,14 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 						platformVolume,
 						workspaceVolume,
 					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 				{
 					Name:    "export",
 					Image:   builderImage,
 					Command: []string{"/lifecycle/exporter"},
-					Args: append([]string{
-						"-layers=/layers",
-						"-helpers=false",
-						"-app=/workspace",
-						"-group=/layers/group.toml",
-						"-analyzed=/layers/analyzed.toml",
-					}, b.Spec.Tags...),
+					Args:    b.exportArgs(builderConfig.RunImage),
 					VolumeMounts: []corev1.VolumeMount{
 						layersVolume,
 						workspaceVolume,
@@ -241,39 +243,131 @@ func (b *Build) BuildPod(config BuildPodConfig, secrets []corev1.Secret, builder
 					Env: []corev1.EnvVar{
 						homeEnv,
 					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 				{
-					Name:    "cache",
-					Image:   builderImage,
-					Command: []string{"/lifecycle/cacher"},
-					Args: []string{
-						"-group=/layers/group.toml",
-						"-layers=/layers",
-						"-path=/cache",
-					},
-					VolumeMounts: []corev1.VolumeMount{
-						layersVolume,
-						cacheVolume,
-					},
-					ImagePullPolicy: corev1.PullIfNotPresent,
+					Name:                     "cache",
+					Image:                    builderImage,
+					Command:                  []string{"/lifecycle/cacher"},
+					Args:                     b.cacheArgs(false),
+					VolumeMounts:             b.cacheVolumeMounts(false),
+					Env:                      b.cacheEnv(false),
+					ImagePullPolicy:          corev1.PullIfNotPresent,
+					TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 				},
 			},
 			ServiceAccountName: b.Spec.ServiceAccount,
 			Volumes:            volumes,
 			ImagePullSecrets:   builder.ImagePullSecrets,
 		},
-	}, nil
+	}
+
+	if b.reusableCache() {
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, b.cacheSizeStep(config, builderConfig))
+	}
+	return pod, nil
 }
 
 const directExecute = "--"
 
+func (b *Build) exportArgs(runImage string) []string {
+	args := []string{
+		"-layers=/layers",
+		"-helpers=false",
+		"-app=/workspace",
+		"-group=/layers/group.toml",
+		"-analyzed=/layers/analyzed.toml",
+	}
+	if runImage != "" {
+		args = append(args, "-image="+runImage)
+	}
+	return append(args, b.ExportTags()...)
+}
+
+// imageTagEnvVars passes the first tag of every destination repository to
+// prepare, which verifies write access to each before the build runs.
+func (b *Build) imageTagEnvVars() []corev1.EnvVar {
+	destinations := b.DestinationTags()
+	envVars := []corev1.EnvVar{
+		{
+			Name:  "IMAGE_TAG",
+			Value: b.Tag(),
+		},
+	}
+	if len(destinations) > 1 {
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "ADDITIONAL_IMAGE_TAGS",
+			Value: strings.Join(destinations[1:], ","),
+		})
+	}
+	return envVars
+}
+
 func buildInitArgs(buildInitBinary string, secretArgs []string) []string {
 	return append(
 		[]string{directExecute, buildInitBinary},
 		secretArgs...)
 }
 
+// cacheArgs configures a lifecycle cache step. A cleared cache is restored from
+// an empty volume so the cache step only keeps the layers of the current build.
+func (b *Build) cacheArgs(clear bool) []string {
+	args := []string{
+		"-group=/layers/group.toml",
+		"-layers=/layers",
+	}
+	if b.Spec.CacheImage != "" && !clear {
+		return append(args, "-image="+b.Spec.CacheImage)
+	}
+	return append(args, "-path=/cache")
+}
+
+// cacheVolumeMounts mounts the home volume instead of the cache volume for a
+// registry cache so the lifecycle uses the registry credentials written by prepare.
+func (b *Build) cacheVolumeMounts(clear bool) []corev1.VolumeMount {
+	if clear {
+		return []corev1.VolumeMount{layersVolume, emptyCacheVolume}
+	}
+	if b.Spec.CacheImage != "" {
+		return []corev1.VolumeMount{layersVolume, homeVolume}
+	}
+	return []corev1.VolumeMount{layersVolume, cacheVolume}
+}
+
+func (b *Build) cacheEnv(clear bool) []corev1.EnvVar {
+	if b.Spec.CacheImage != "" && !clear {
+		return []corev1.EnvVar{homeEnv}
+	}
+	return nil
+}
+
+// cacheSizeStep reports the size of a reusable cache once the cache step wrote it.
+func (b *Build) cacheSizeStep(config BuildPodConfig, builderConfig BuildPodBuilderConfig) corev1.Container {
+	args := []string{"-cache-size-dir=/cache"}
+	if b.Spec.CacheImage != "" {
+		args = []string{"-cache-size-image=" + b.Spec.CacheImage}
+	}
+
+	return corev1.Container{
+		Name:  CacheSizeContainerName,
+		Image: config.BuildInitImage,
+		SecurityContext: &corev1.SecurityContext{
+			RunAsUser:  &builderConfig.Uid,
+			RunAsGroup: &builderConfig.Gid,
+		},
+		Args:                     buildInitArgs(buildInitBinary, args),
+		VolumeMounts:             b.cacheVolumeMounts(false),
+		Env:                      b.cacheEnv(false),
+		ImagePullPolicy:          corev1.PullIfNotPresent,
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+}
+
+func (b *Build) reusableCache() bool {
+	return b.Spec.CacheName != "" || b.Spec.CacheImage != ""
+}
+
 func (b *Build) cacheVolume() corev1.VolumeSource {
 	if b.Spec.CacheName != "" {
 		return corev1.VolumeSource{
@@ -361,9 +455,61 @@ func (b *Build) setupVolumes() []corev1.Volume {
 		},
 	}
 
+	if b.Spec.ClearCache {
+		volumes = append(volumes, corev1.Volume{
+			Name: emptyCacheDirName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
 	return append(volumes, b.ImagePullSecretsVolume())
 }
 
+// pullSecretsVolume projects every pull secret into its own numbered subdirectory
+// so secrets sharing the same docker config key do not collide.
+func pullSecretsVolume(name string, secrets []corev1.LocalObjectReference) corev1.Volume {
+	if len(secrets) == 0 {
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		}
+	}
+
+	optional := true
+	sources := make([]corev1.VolumeProjection, 0, len(secrets))
+	for i, secret := range secrets {
+		sources = append(sources, corev1.VolumeProjection{
+			Secret: &corev1.SecretProjection{
+				LocalObjectReference: secret,
+				Items: []corev1.KeyToPath{
+					{
+						Key:  corev1.DockerConfigJsonKey,
+						Path: fmt.Sprintf("%d/%s", i, corev1.DockerConfigJsonKey),
+					},
+					{
+						Key:  corev1.DockerConfigKey,
+						Path: fmt.Sprintf("%d/%s", i, corev1.DockerConfigKey),
+					},
+				},
+				Optional: &optional,
+			},
+		})
+	}
+
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: sources,
+			},
+		},
+	}
+}
+
 func (b *Build) labels(additionalLabels map[string]string) map[string]string {
 	labels := make(map[string]string, len(additionalLabels)+len(b.Labels))
 
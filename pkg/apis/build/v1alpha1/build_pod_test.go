@@ -108,14 +108,14 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		NopImage:       "no/op:image",
 	}
 
-	userAndGroup := v1alpha1.UserAndGroup{
+	builderConfig := v1alpha1.BuildPodBuilderConfig{
 		Uid: 2000,
 		Gid: 3000,
 	}
 
 	when("BuildPod", func() {
 		it("creates a pod with a builder owner reference and build label", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.ObjectMeta, metav1.ObjectMeta{
@@ -132,21 +132,21 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("creates a pod with a correct service account", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, serviceAccount, pod.Spec.ServiceAccountName)
 		})
 
 		it("configures the FS Mount Group with the supplied group", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
-			assert.Equal(t, userAndGroup.Gid, *pod.Spec.SecurityContext.FSGroup)
+			assert.Equal(t, builderConfig.Gid, *pod.Spec.SecurityContext.FSGroup)
 		})
 
 		it("creates init containers with all the build steps", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Len(t, pod.Spec.InitContainers, len([]string{
@@ -157,13 +157,14 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				"build",
 				"export",
 				"cache",
+				"cache-size",
 			}))
 		})
 
 		it("configures the workspace volume with a subPath", func() {
 			build.Spec.Source.SubPath = "some/path"
 
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			vol := getVolumeMountFromContainer(t, pod.Spec.InitContainers, "prepare", "workspace-dir")
@@ -178,7 +179,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures prepare with docker and git credentials", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[0].Name, "prepare")
@@ -204,13 +205,13 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures prepare with the build configuration", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[0].Name, "prepare")
 			assert.Equal(t, pod.Spec.InitContainers[0].Image, config.BuildInitImage)
-			assert.Equal(t, userAndGroup.Uid, *pod.Spec.InitContainers[0].SecurityContext.RunAsUser)
-			assert.Equal(t, userAndGroup.Gid, *pod.Spec.InitContainers[0].SecurityContext.RunAsGroup)
+			assert.Equal(t, builderConfig.Uid, *pod.Spec.InitContainers[0].SecurityContext.RunAsUser)
+			assert.Equal(t, builderConfig.Gid, *pod.Spec.InitContainers[0].SecurityContext.RunAsGroup)
 			assert.Contains(t, pod.Spec.InitContainers[0].Env,
 				corev1.EnvVar{
 					Name:  "PLATFORM_ENV_VARS",
@@ -245,8 +246,29 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		it("passes the first tag of every other destination repository to prepare", func() {
+			build.Spec.Tags = append(build.Spec.Tags, "eu.gcr.io/someimage/name", "eu.gcr.io/someimage/name:tag2", "other.registry.io/name:tag")
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			assert.Contains(t, pod.Spec.InitContainers[0].Env, corev1.EnvVar{
+				Name:  "ADDITIONAL_IMAGE_TAGS",
+				Value: "eu.gcr.io/someimage/name,other.registry.io/name:tag",
+			})
+		})
+
+		it("does not pass additional tags in the build repository to prepare", func() {
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			for _, envVar := range pod.Spec.InitContainers[0].Env {
+				assert.NotEqual(t, "ADDITIONAL_IMAGE_TAGS", envVar.Name)
+			}
+		})
+
 		it("configures the prepare step for git source", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
@@ -268,7 +290,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			build.Spec.Source.Blob = &v1alpha1.Blob{
 				URL: "https://some-blobstore.example.com/some-blob",
 			}
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
@@ -286,7 +308,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			build.Spec.Source.Registry = &v1alpha1.Registry{
 				Image: "some-registry.io/some-image",
 			}
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
@@ -312,9 +334,10 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				Image: "some-registry.io/some-image",
 				ImagePullSecrets: []corev1.LocalObjectReference{
 					{Name: "foo"},
+					{Name: "bar"},
 				},
 			}
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
@@ -324,8 +347,36 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 					MountPath: "/imagePullSecrets",
 					ReadOnly:  true,
 				})
-			require.NotNil(t, *pod.Spec.Volumes[5].Secret)
-			assert.Equal(t, "foo", pod.Spec.Volumes[5].Secret.SecretName)
+			optional := true
+			assert.Equal(t, corev1.Volume{
+				Name: "image-pull-secrets-dir",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{
+								Secret: &corev1.SecretProjection{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "foo"},
+									Items: []corev1.KeyToPath{
+										{Key: ".dockerconfigjson", Path: "0/.dockerconfigjson"},
+										{Key: ".dockercfg", Path: "0/.dockercfg"},
+									},
+									Optional: &optional,
+								},
+							},
+							{
+								Secret: &corev1.SecretProjection{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "bar"},
+									Items: []corev1.KeyToPath{
+										{Key: ".dockerconfigjson", Path: "1/.dockerconfigjson"},
+										{Key: ".dockercfg", Path: "1/.dockercfg"},
+									},
+									Optional: &optional,
+								},
+							},
+						},
+					},
+				},
+			}, pod.Spec.Volumes[5])
 			assert.Equal(t, config.BuildInitImage, pod.Spec.InitContainers[0].Image)
 			assert.Contains(t, pod.Spec.InitContainers[0].Env,
 				corev1.EnvVar{
@@ -335,7 +386,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures detect step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[1].Name, "detect")
@@ -348,7 +399,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures restore step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[2].Name, "restore")
@@ -360,7 +411,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures analyze step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[3].Name, "analyze")
@@ -380,7 +431,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures build step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[4].Name, "build")
@@ -393,7 +444,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("configures export step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[5].Name, "export")
@@ -415,8 +466,48 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			}, pod.Spec.InitContainers[5].Args)
 		})
 
+		it("only exports to the tags in the registry of the build tag", func() {
+			build.Spec.Tags = append(build.Spec.Tags, "index.docker.io/someimage/mirror:tag", "eu.gcr.io/someimage/name", "registry.example.com/mirror/name:latest")
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			assert.Equal(t, pod.Spec.InitContainers[5].Name, "export")
+			assert.Equal(t, []string{
+				"-layers=/layers",
+				"-helpers=false",
+				"-app=/workspace",
+				"-group=/layers/group.toml",
+				"-analyzed=/layers/analyzed.toml",
+				build.Tag(),
+				"someimage/name:tag2",
+				"someimage/name:tag3",
+				"index.docker.io/someimage/mirror:tag",
+			}, pod.Spec.InitContainers[5].Args)
+		})
+
+		it("exports on the run image selected for the tag registry", func() {
+			builderConfig.RunImage = "some.registry.io/run@sha256:run-digest"
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			assert.Equal(t, pod.Spec.InitContainers[5].Name, "export")
+			assert.Equal(t, []string{
+				"-layers=/layers",
+				"-helpers=false",
+				"-app=/workspace",
+				"-group=/layers/group.toml",
+				"-analyzed=/layers/analyzed.toml",
+				"-image=some.registry.io/run@sha256:run-digest",
+				build.Tag(),
+				"someimage/name:tag2",
+				"someimage/name:tag3",
+			}, pod.Spec.InitContainers[5].Args)
+		})
+
 		it("configures cache step", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assert.Equal(t, pod.Spec.InitContainers[6].Name, "cache")
@@ -427,19 +518,141 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			}))
 		})
 
+		it("configures restore and cache steps with a registry cache", func() {
+			build.Spec.CacheName = ""
+			build.Spec.CacheImage = "someimage/name:cache"
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			for _, container := range []corev1.Container{pod.Spec.InitContainers[2], pod.Spec.InitContainers[6]} {
+				assert.Equal(t, []string{
+					"-group=/layers/group.toml",
+					"-layers=/layers",
+					"-image=someimage/name:cache",
+				}, container.Args)
+				assert.Equal(t, []string{"layers-dir", "home-dir"}, volumeMountNames(container.VolumeMounts))
+				assert.Equal(t, []corev1.EnvVar{{Name: "HOME", Value: "/builder/home"}}, container.Env)
+			}
+		})
+
+		it("configures the cache size step for a volume cache", func() {
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			cacheSize := pod.Spec.InitContainers[7]
+			assert.Equal(t, "cache-size", cacheSize.Name)
+			assert.Equal(t, config.BuildInitImage, cacheSize.Image)
+			assert.Equal(t, []string{
+				"--",
+				"/layers/org.cloudfoundry.go-mod/app-binary/build-init",
+				"-cache-size-dir=/cache",
+			}, cacheSize.Args)
+			assert.Equal(t, []string{"layers-dir", "cache-dir"}, volumeMountNames(cacheSize.VolumeMounts))
+			assert.Equal(t, &builderConfig.Uid, cacheSize.SecurityContext.RunAsUser)
+			assert.Equal(t, &builderConfig.Gid, cacheSize.SecurityContext.RunAsGroup)
+		})
+
+		it("configures the cache size step for a registry cache", func() {
+			build.Spec.CacheName = ""
+			build.Spec.CacheImage = "someimage/name:cache"
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			cacheSize := pod.Spec.InitContainers[7]
+			assert.Equal(t, "cache-size", cacheSize.Name)
+			assert.Equal(t, []string{
+				"--",
+				"/layers/org.cloudfoundry.go-mod/app-binary/build-init",
+				"-cache-size-image=someimage/name:cache",
+			}, cacheSize.Args)
+			assert.Equal(t, []string{"layers-dir", "home-dir"}, volumeMountNames(cacheSize.VolumeMounts))
+			assert.Equal(t, []corev1.EnvVar{{Name: "HOME", Value: "/builder/home"}}, cacheSize.Env)
+		})
+
+		it("does not measure an empty cache", func() {
+			build.Spec.CacheName = ""
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			require.Len(t, pod.Spec.InitContainers, 7)
+			assert.Equal(t, "cache", pod.Spec.InitContainers[6].Name)
+		})
+
+		it("restores from an empty cache when the cache is cleared", func() {
+			build.Spec.ClearCache = true
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			restore := pod.Spec.InitContainers[2]
+			assert.Equal(t, []string{
+				"-group=/layers/group.toml",
+				"-layers=/layers",
+				"-path=/cache",
+			}, restore.Args)
+			assert.Equal(t, []string{"layers-dir", "empty-cache-dir"}, volumeMountNames(restore.VolumeMounts))
+			assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+				Name: "empty-cache-dir",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+
+			cache := pod.Spec.InitContainers[6]
+			assert.Equal(t, []string{"layers-dir", "cache-dir"}, volumeMountNames(cache.VolumeMounts))
+		})
+
+		it("restores from an empty cache instead of the registry cache when the cache is cleared", func() {
+			build.Spec.CacheName = ""
+			build.Spec.CacheImage = "someimage/name:cache"
+			build.Spec.ClearCache = true
+
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			restore := pod.Spec.InitContainers[2]
+			assert.Equal(t, []string{
+				"-group=/layers/group.toml",
+				"-layers=/layers",
+				"-path=/cache",
+			}, restore.Args)
+			assert.Nil(t, restore.Env)
+
+			cache := pod.Spec.InitContainers[6]
+			assert.Equal(t, []string{
+				"-group=/layers/group.toml",
+				"-layers=/layers",
+				"-image=someimage/name:cache",
+			}, cache.Args)
+		})
+
 		it("configures the builder image in all lifecycle steps", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			for _, container := range pod.Spec.InitContainers {
-				if container.Name != "prepare" {
+				if container.Name != "prepare" && container.Name != "cache-size" {
 					assert.Equal(t, builderImage, container.Image, fmt.Sprintf("image on container '%s'", container.Name))
 				}
 			}
 		})
 
+		it("falls back to logs for the termination message of lifecycle steps", func() {
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			for _, container := range pod.Spec.InitContainers {
+				if container.Name != "prepare" {
+					assert.Equal(t, corev1.TerminationMessageFallbackToLogsOnError, container.TerminationMessagePolicy, fmt.Sprintf("termination message policy on container '%s'", container.Name))
+				}
+			}
+		})
+
 		it("configures the nop container with resources", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			nopContainer := pod.Spec.Containers[0]
@@ -447,7 +660,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("creates a pod with reusable cache when name is provided", func() {
-			pod, err := build.BuildPod(config, nil, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, nil, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			require.Len(t, pod.Spec.Volumes, 7)
@@ -461,7 +674,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 
 		it("creates a pod with empty cache when no name is provided", func() {
 			build.Spec.CacheName = ""
-			pod, err := build.BuildPod(config, nil, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, nil, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			require.Len(t, pod.Spec.Volumes, 7)
@@ -474,7 +687,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("attach volumes for secrets", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			assertSecretPresent(t, pod, "git-secret-1")
@@ -483,13 +696,73 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("attach image pull secrets to pod", func() {
-			pod, err := build.BuildPod(config, secrets, imageRef, userAndGroup)
+			pod, err := build.BuildPod(config, secrets, imageRef, builderConfig)
 			require.NoError(t, err)
 
 			require.Len(t, pod.Spec.ImagePullSecrets, 1)
 			assert.Equal(t, corev1.LocalObjectReference{Name: "some-image-secret"}, pod.Spec.ImagePullSecrets[0])
 		})
 	})
+
+	when("PlatformBuildPod", func() {
+		it.Before(func() {
+			build.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+		})
+
+		it("creates a pod per platform scheduled on nodes of the platform", func() {
+			pod, err := build.PlatformBuildPod("linux/arm64", config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			assert.Equal(t, "build-name-build-pod-linux-arm64", pod.Name)
+			assert.Equal(t, map[string]string{
+				"some/label":                "to-pass-through",
+				"build.pivotal.io/build":    buildName,
+				"build.pivotal.io/platform": "linux-arm64",
+			}, pod.Labels)
+			assert.Equal(t, map[string]string{
+				"kubernetes.io/os":   "linux",
+				"kubernetes.io/arch": "arm64",
+			}, pod.Spec.NodeSelector)
+		})
+
+		it("analyzes and exports the image of the platform to the platform tag", func() {
+			pod, err := build.PlatformBuildPod("linux/arm64", config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+
+			assert.Equal(t, "analyze", pod.Spec.InitContainers[3].Name)
+			assert.Contains(t, pod.Spec.InitContainers[3].Args, "someimage/name:latest-linux-arm64")
+			assert.Equal(t, "export", pod.Spec.InitContainers[5].Name)
+			assert.Equal(t, []string{
+				"-layers=/layers",
+				"-helpers=false",
+				"-app=/workspace",
+				"-group=/layers/group.toml",
+				"-analyzed=/layers/analyzed.toml",
+				"someimage/name:latest-linux-arm64",
+			}, pod.Spec.InitContainers[5].Args)
+		})
+
+		it("uses a registry cache per platform instead of the volume cache", func() {
+			pod, err := build.PlatformBuildPod("linux/arm64", config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+			assert.Equal(t, corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}, pod.Spec.Volumes[0].VolumeSource)
+
+			build.Spec.CacheName = ""
+			build.Spec.CacheImage = "someimage/name:cache"
+			pod, err = build.PlatformBuildPod("linux/arm64", config, secrets, imageRef, builderConfig)
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				"-group=/layers/group.toml",
+				"-layers=/layers",
+				"-image=someimage/name:cache-linux-arm64",
+			}, pod.Spec.InitContainers[2].Args)
+		})
+
+		it("returns an error for an invalid platform", func() {
+			_, err := build.PlatformBuildPod("linux", config, secrets, imageRef, builderConfig)
+			require.EqualError(t, err, "platform linux is not of the form os/arch[/variant]")
+		})
+	})
 }
 
 func assertSecretPresent(t *testing.T, pod *corev1.Pod, secretName string) {
@@ -528,3 +801,11 @@ func getVolumeMountFromContainer(t *testing.T, containers []corev1.Container, co
 	t.Errorf("could not find volume mount with name %s in container %s", volumeName, containerName)
 	return corev1.VolumeMount{}
 }
+
+func volumeMountNames(mounts []corev1.VolumeMount) []string {
+	var names []string
+	for _, mount := range mounts {
+		names = append(names, mount.Name)
+	}
+	return names
+}
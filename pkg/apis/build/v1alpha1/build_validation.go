@@ -19,13 +19,70 @@ package v1alpha1
 import (
 	"context"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/kmp"
 )
 
-func (a *Build) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+func (b *Build) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return b.Spec.Validate(ctx).ViaField("spec").
+		Also(b.validateImmutableFields(ctx))
+}
+
+func (bs *BuildSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateTags(bs.Tags).
+		Also(validatePlatforms(bs.Platforms)).
+		Also(bs.Builder.Validate(ctx).ViaField("builder")).
+		Also(bs.Source.Validate(ctx).ViaField("source"))
+}
+
+func (bs *BuildBuilderSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateImage(bs.Image)
 }
 
-func (as *BuildSpec) Validate(ctx context.Context) *apis.FieldError {
+func (b *Build) validateImmutableFields(ctx context.Context) *apis.FieldError {
+	if !apis.IsInUpdate(ctx) {
+		return nil
+	}
+
+	original, ok := apis.GetBaseline(ctx).(*Build)
+	if !ok || original == nil {
+		return nil
+	}
+
+	diff, err := kmp.ShortDiff(original.Spec, b.Spec)
+	if err != nil {
+		return &apis.FieldError{
+			Message: "Failed to diff Build",
+			Paths:   []string{"spec"},
+			Details: err.Error(),
+		}
+	}
+
+	if diff != "" {
+		return &apis.FieldError{
+			Message: "Immutable fields changed (-old +new)",
+			Paths:   []string{"spec"},
+			Details: diff,
+		}
+	}
 	return nil
 }
+
+func validateTags(tags []string) *apis.FieldError {
+	if len(tags) == 0 {
+		return apis.ErrMissingField("tags")
+	}
+
+	var errs *apis.FieldError
+	for i, tag := range tags {
+		if _, err := name.NewTag(tag, name.WeakValidation); err != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(tag, "tags", i))
+		}
+	}
+	return errs
+}
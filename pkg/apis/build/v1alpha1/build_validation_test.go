@@ -0,0 +1,89 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestBuildValidation(t *testing.T) {
+	spec.Run(t, "Build Validation", testBuildValidation)
+}
+
+func testBuildValidation(t *testing.T, when spec.G, it spec.S) {
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "build-name",
+		},
+		Spec: v1alpha1.BuildSpec{
+			Tags: []string{"some/image", "some/image:b1.20191015.120000"},
+			Builder: v1alpha1.BuildBuilderSpec{
+				Image: "builder/bionic-builder@sha256:e431a4f94fb84854fd081da62762192b36fd093fdfb85ad2e17e6bf8eb0cf2e1",
+			},
+			ServiceAccount: "some/service-account",
+			Source: v1alpha1.SourceConfig{
+				Blob: &v1alpha1.Blob{
+					URL: "http://blob.com/url",
+				},
+			},
+		},
+	}
+
+	when("Validate", func() {
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, build.Validate(context.TODO()))
+		})
+
+		assertValidationError := func(build *v1alpha1.Build, ctx context.Context, expectedError *apis.FieldError) {
+			t.Helper()
+			err := build.Validate(ctx)
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("missing tags", func() {
+			build.Spec.Tags = nil
+			assertValidationError(build, context.TODO(), apis.ErrMissingField("tags").ViaField("spec"))
+		})
+
+		it("invalid tags", func() {
+			build.Spec.Tags = append(build.Spec.Tags, "ftp//invalid/tag@@")
+			assertValidationError(build, context.TODO(), apis.ErrInvalidArrayValue("ftp//invalid/tag@@", "tags", 2).ViaField("spec"))
+		})
+
+		it("missing builder image", func() {
+			build.Spec.Builder.Image = ""
+			assertValidationError(build, context.TODO(), apis.ErrMissingField("image").ViaField("spec", "builder"))
+		})
+
+		it("multiple sources", func() {
+			build.Spec.Source.Git = &v1alpha1.Git{
+				URL:      "http://github.com/repo",
+				Revision: "master",
+			}
+			assertValidationError(build, context.TODO(), apis.ErrMultipleOneOf("git", "blob").ViaField("spec", "source"))
+		})
+
+		it("allows updates that do not change the spec", func() {
+			original := build.DeepCopy()
+			build.Labels = map[string]string{"some": "label"}
+
+			assert.Nil(t, build.Validate(apis.WithinUpdate(context.TODO(), original)))
+		})
+
+		it("rejects updates that change the spec", func() {
+			original := build.DeepCopy()
+			build.Spec.Tags = []string{"some/other-image"}
+
+			err := build.Validate(apis.WithinUpdate(context.TODO(), original))
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), "Immutable fields changed (-old +new): spec")
+		})
+	})
+}
@@ -2,9 +2,14 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 )
 
+// ApprovedBuilderImageAnnotation promotes the pending update of a builder with
+// the approval update policy when its value matches status.pendingUpdate.
+const ApprovedBuilderImageAnnotation = "build.pivotal.io/approvedBuilderImage"
+
 func (b *Builder) Ready() bool {
 	return b.Status.GetCondition(duckv1alpha1.ConditionReady).IsTrue() &&
 		(b.Generation == b.Status.ObservedGeneration)
@@ -21,6 +26,10 @@ func (b *Builder) ImagePullSecrets() []v1.LocalObjectReference {
 	return b.Spec.ImagePullSecrets
 }
 
+func (b *Builder) ServiceAccount() string {
+	return ""
+}
+
 func (b *Builder) Image() string {
 	return b.Spec.Image
 }
@@ -32,3 +41,30 @@ func (b *Builder) BuildpackMetadata() BuildpackMetadataList {
 func (b *Builder) RunImage() string {
 	return b.Status.RunImage
 }
+
+func (b *Builder) UpdateApproved(latestImage string) bool {
+	return updateApproved(b.ObjectMeta, b.Spec.BuilderSpec, b.Status, latestImage)
+}
+
+// updateApproved reports whether latestImage may replace the builder image in
+// use. A spec change approves the image it resolves to.
+func updateApproved(meta metav1.ObjectMeta, spec BuilderSpec, status BuilderStatus, latestImage string) bool {
+	if spec.UpdatePolicy != Approval || status.LatestImage == "" || status.LatestImage == latestImage {
+		return true
+	}
+
+	if meta.Generation != status.ObservedGeneration {
+		return true
+	}
+
+	return meta.Annotations[ApprovedBuilderImageAnnotation] == latestImage
+}
+
+// PreviousImageFor returns the builder image replaced by latestImage. The
+// recorded previous image is kept when the builder digest has not changed.
+func (bs *BuilderStatus) PreviousImageFor(latestImage string) string {
+	if bs.LatestImage != "" && bs.LatestImage != latestImage {
+		return bs.LatestImage
+	}
+	return bs.PreviousImage
+}
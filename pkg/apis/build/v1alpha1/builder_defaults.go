@@ -0,0 +1,17 @@
+package v1alpha1
+
+import "context"
+
+func (b *Builder) SetDefaults(ctx context.Context) {
+	// nothing to do
+}
+
+func (c *ClusterBuilder) SetDefaults(ctx context.Context) {
+	// nothing to do
+}
+
+func (c *CustomBuilder) SetDefaults(ctx context.Context) {
+	if c.Spec.ServiceAccount == "" {
+		c.Spec.ServiceAccount = defaultServiceAccount
+	}
+}
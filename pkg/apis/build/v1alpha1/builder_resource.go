@@ -10,6 +10,7 @@ type BuilderResource interface {
 	BuildBuilderSpec() BuildBuilderSpec
 	Image() string
 	ImagePullSecrets() []v1.LocalObjectReference
+	ServiceAccount() string
 	Ready() bool
 	BuildpackMetadata() BuildpackMetadataList
 	RunImage() string
@@ -4,6 +4,7 @@ import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 )
 
@@ -20,6 +21,11 @@ type Builder struct {
 	Status BuilderStatus          `json:"status"`
 }
 
+var (
+	_ apis.Validatable = (*Builder)(nil)
+	_ apis.Defaultable = (*Builder)(nil)
+)
+
 type BuilderSpec struct {
 	Image        string              `json:"image"`
 	UpdatePolicy BuilderUpdatePolicy `json:"updatePolicy"`
@@ -35,13 +41,20 @@ type BuilderUpdatePolicy string
 const (
 	Polling  BuilderUpdatePolicy = "polling"
 	External BuilderUpdatePolicy = "external"
+	Approval BuilderUpdatePolicy = "approval"
 )
 
 type BuilderStatus struct {
 	duckv1alpha1.Status `json:",inline"`
 	BuilderMetadata     BuildpackMetadataList `json:"builderMetadata"`
+	Order               []OrderEntry          `json:"order,omitempty"`
+	StackID             string                `json:"stackId,omitempty"`
+	LifecycleVersion    string                `json:"lifecycleVersion,omitempty"`
 	RunImage            string                `json:"runImage"`
 	LatestImage         string                `json:"latestImage"`
+	PreviousImage       string                `json:"previousImage,omitempty"`
+	PendingUpdate       string                `json:"pendingUpdate,omitempty"`
+	ObservedRevisions   []string              `json:"observedRevisions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,36 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (b *Builder) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return b.Spec.BuilderSpec.Validate(ctx).ViaField("spec")
+}
+
+func (c *ClusterBuilder) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return c.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (bs *BuilderSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateImage(bs.Image).Also(bs.validateUpdatePolicy())
+}
+
+func (bs *BuilderSpec) validateUpdatePolicy() *apis.FieldError {
+	switch bs.UpdatePolicy {
+	case "", Polling, External, Approval:
+		return nil
+	default:
+		return apis.ErrInvalidValue(bs.UpdatePolicy, "updatePolicy")
+	}
+}
@@ -0,0 +1,73 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestBuilderValidation(t *testing.T) {
+	spec.Run(t, "Builder Validation", testBuilderValidation)
+}
+
+func testBuilderValidation(t *testing.T, when spec.G, it spec.S) {
+	when("Builder", func() {
+		builder := &v1alpha1.Builder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "builder-name",
+			},
+			Spec: v1alpha1.BuilderWithSecretsSpec{
+				BuilderSpec: v1alpha1.BuilderSpec{
+					Image:        "cloudfoundry/cnb:bionic",
+					UpdatePolicy: v1alpha1.Polling,
+				},
+			},
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, builder.Validate(context.TODO()))
+		})
+
+		it("missing image", func() {
+			builder.Spec.Image = ""
+			assert.EqualError(t, builder.Validate(context.TODO()), apis.ErrMissingField("image").ViaField("spec").Error())
+		})
+
+		it("accepts the approval update policy", func() {
+			builder.Spec.UpdatePolicy = v1alpha1.Approval
+			assert.Nil(t, builder.Validate(context.TODO()))
+		})
+
+		it("invalid update policy", func() {
+			builder.Spec.UpdatePolicy = "sometimes"
+			assert.EqualError(t, builder.Validate(context.TODO()), apis.ErrInvalidValue("sometimes", "updatePolicy").ViaField("spec").Error())
+		})
+	})
+
+	when("ClusterBuilder", func() {
+		clusterBuilder := &v1alpha1.ClusterBuilder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "cluster-builder-name",
+			},
+			Spec: v1alpha1.BuilderSpec{
+				Image:        "cloudfoundry/cnb:bionic",
+				UpdatePolicy: v1alpha1.External,
+			},
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, clusterBuilder.Validate(context.TODO()))
+		})
+
+		it("invalid image", func() {
+			clusterBuilder.Spec.Image = "invalid@@image"
+			assert.EqualError(t, clusterBuilder.Validate(context.TODO()), apis.ErrInvalidValue("invalid@@image", "image").ViaField("spec").Error())
+		})
+	})
+}
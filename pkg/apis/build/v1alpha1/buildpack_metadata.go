@@ -3,8 +3,9 @@ package v1alpha1
 type BuildpackMetadataList []BuildpackMetadata
 
 type BuildpackMetadata struct {
-	ID      string `json:"key"`
-	Version string `json:"version"`
+	ID       string `json:"key"`
+	Version  string `json:"version"`
+	Homepage string `json:"homepage,omitempty"`
 }
 
 func (l BuildpackMetadataList) Include(q BuildpackMetadata) bool {
@@ -28,6 +28,14 @@ func (c *ClusterBuilder) ImagePullSecrets() []v1.LocalObjectReference {
 	return nil
 }
 
+func (c *ClusterBuilder) ServiceAccount() string {
+	return ""
+}
+
 func (c *ClusterBuilder) RunImage() string {
 	return c.Status.RunImage
 }
+
+func (c *ClusterBuilder) UpdateApproved(latestImage string) bool {
+	return updateApproved(c.ObjectMeta, c.Spec, c.Status, latestImage)
+}
@@ -4,6 +4,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 )
 
 const ClusterBuilderKind = "ClusterBuilder"
@@ -20,6 +21,11 @@ type ClusterBuilder struct {
 	Status BuilderStatus `json:"status"`
 }
 
+var (
+	_ apis.Validatable = (*ClusterBuilder)(nil)
+	_ apis.Defaultable = (*ClusterBuilder)(nil)
+)
+
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
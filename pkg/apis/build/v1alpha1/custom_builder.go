@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+func (c *CustomBuilder) Ready() bool {
+	return c.Status.GetCondition(duckv1alpha1.ConditionReady).IsTrue() &&
+		(c.Generation == c.Status.ObservedGeneration)
+}
+
+func (c *CustomBuilder) BuildBuilderSpec() BuildBuilderSpec {
+	return BuildBuilderSpec{
+		Image:            c.Status.LatestImage,
+		ImagePullSecrets: c.Spec.ImagePullSecrets,
+	}
+}
+
+func (c *CustomBuilder) ImagePullSecrets() []v1.LocalObjectReference {
+	return c.Spec.ImagePullSecrets
+}
+
+func (c *CustomBuilder) ServiceAccount() string {
+	return c.Spec.ServiceAccount
+}
+
+func (c *CustomBuilder) Image() string {
+	if c.Status.LatestImage != "" {
+		return c.Status.LatestImage
+	}
+	return c.Spec.Tag
+}
+
+func (c *CustomBuilder) BuildpackMetadata() BuildpackMetadataList {
+	return c.Status.BuilderMetadata
+}
+
+func (c *CustomBuilder) RunImage() string {
+	return c.Status.RunImage
+}
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const CustomBuilderKind = "CustomBuilder"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type CustomBuilder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomBuilderSpec `json:"spec"`
+	Status BuilderStatus     `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*CustomBuilder)(nil)
+	_ apis.Defaultable = (*CustomBuilder)(nil)
+)
+
+type CustomBuilderSpec struct {
+	Tag              string                    `json:"tag"`
+	Stack            CustomStack               `json:"stack"`
+	Store            []StoreImage              `json:"store"`
+	Order            []OrderEntry              `json:"order"`
+	ServiceAccount   string                    `json:"serviceAccount,omitempty"`
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+type CustomStack struct {
+	ID         string `json:"id"`
+	BuildImage string `json:"buildImage"`
+	RunImage   string `json:"runImage"`
+}
+
+type StoreImage struct {
+	Image string `json:"image"`
+}
+
+type OrderEntry struct {
+	Group []BuildpackRef `json:"group"`
+}
+
+type BuildpackRef struct {
+	ID       string `json:"id"`
+	Version  string `json:"version,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CustomBuilderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CustomBuilder `json:"items"`
+}
+
+func (*CustomBuilder) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(CustomBuilderKind)
+}
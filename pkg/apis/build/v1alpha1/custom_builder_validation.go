@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (c *CustomBuilder) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return c.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (s *CustomBuilderSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateTag(s.Tag).
+		Also(s.Stack.Validate(ctx).ViaField("stack")).
+		Also(s.validateStore()).
+		Also(s.validateOrder())
+}
+
+func (s *CustomStack) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if s.ID == "" {
+		errs = errs.Also(apis.ErrMissingField("id"))
+	}
+
+	return errs.
+		Also(validateImageField(s.BuildImage, "buildImage")).
+		Also(validateImageField(s.RunImage, "runImage"))
+}
+
+func (s *CustomBuilderSpec) validateStore() *apis.FieldError {
+	if len(s.Store) == 0 {
+		return apis.ErrMissingField("store")
+	}
+
+	var errs *apis.FieldError
+	for i, storeImage := range s.Store {
+		errs = errs.Also(validateImage(storeImage.Image).ViaFieldIndex("store", i))
+	}
+	return errs
+}
+
+func (s *CustomBuilderSpec) validateOrder() *apis.FieldError {
+	if len(s.Order) == 0 {
+		return apis.ErrMissingField("order")
+	}
+
+	var errs *apis.FieldError
+	for i, entry := range s.Order {
+		if len(entry.Group) == 0 {
+			errs = errs.Also(apis.ErrMissingField("group").ViaFieldIndex("order", i))
+			continue
+		}
+
+		for j, ref := range entry.Group {
+			if ref.ID == "" {
+				errs = errs.Also(apis.ErrMissingField("id").ViaFieldIndex("group", j).ViaFieldIndex("order", i))
+			}
+		}
+	}
+	return errs
+}
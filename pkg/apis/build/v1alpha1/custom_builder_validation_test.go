@@ -0,0 +1,80 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestCustomBuilderValidation(t *testing.T) {
+	spec.Run(t, "Custom Builder Validation", testCustomBuilderValidation)
+}
+
+func testCustomBuilderValidation(t *testing.T, when spec.G, it spec.S) {
+	customBuilder := &v1alpha1.CustomBuilder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "custom-builder-name",
+		},
+		Spec: v1alpha1.CustomBuilderSpec{
+			Tag: "gcr.io/org/custom-builder",
+			Stack: v1alpha1.CustomStack{
+				ID:         "io.buildpacks.stacks.bionic",
+				BuildImage: "cloudfoundry/build:base-cnb",
+				RunImage:   "cloudfoundry/run:base-cnb",
+			},
+			Store: []v1alpha1.StoreImage{
+				{Image: "gcr.io/org/buildpackage"},
+			},
+			Order: []v1alpha1.OrderEntry{
+				{
+					Group: []v1alpha1.BuildpackRef{
+						{ID: "org.buildpack", Version: "1.0.0"},
+					},
+				},
+			},
+		},
+	}
+
+	it("returns nil on no validation error", func() {
+		assert.Nil(t, customBuilder.Validate(context.TODO()))
+	})
+
+	it("missing tag", func() {
+		customBuilder.Spec.Tag = ""
+		assert.EqualError(t, customBuilder.Validate(context.TODO()), apis.ErrMissingField("tag").ViaField("spec").Error())
+	})
+
+	it("missing stack fields", func() {
+		customBuilder.Spec.Stack = v1alpha1.CustomStack{}
+		assert.EqualError(t, customBuilder.Validate(context.TODO()),
+			apis.ErrMissingField("id", "buildImage", "runImage").ViaField("stack").ViaField("spec").Error())
+	})
+
+	it("invalid store image", func() {
+		customBuilder.Spec.Store[0].Image = "invalid@@image"
+		assert.EqualError(t, customBuilder.Validate(context.TODO()),
+			apis.ErrInvalidValue("invalid@@image", "image").ViaFieldIndex("store", 0).ViaField("spec").Error())
+	})
+
+	it("missing order", func() {
+		customBuilder.Spec.Order = nil
+		assert.EqualError(t, customBuilder.Validate(context.TODO()), apis.ErrMissingField("order").ViaField("spec").Error())
+	})
+
+	it("missing buildpack id in a group", func() {
+		customBuilder.Spec.Order[0].Group[0].ID = ""
+		assert.EqualError(t, customBuilder.Validate(context.TODO()),
+			apis.ErrMissingField("id").ViaFieldIndex("group", 0).ViaFieldIndex("order", 0).ViaField("spec").Error())
+	})
+
+	it("defaults the service account", func() {
+		customBuilder.SetDefaults(context.TODO())
+		assert.Equal(t, "default", customBuilder.Spec.ServiceAccount)
+	})
+}
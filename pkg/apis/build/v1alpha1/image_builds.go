@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/name"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/kmeta"
 )
@@ -22,9 +23,13 @@ const (
 	BuildReasonCommit     = "COMMIT"
 	BuildReasonBuildpack  = "BUILDPACK"
 	BuildReasonStack      = "STACK"
+
+	// ClearCacheAnnotation requests an empty build cache for the next build of an
+	// image. Any new value is a new request, e.g. the current time.
+	ClearCacheAnnotation = "image.build.pivotal.io/clearCache"
 )
 
-func (im *Image) buildNeeded(lastBuild *Build, sourceResolver *SourceResolver, builder BuilderResource) ([]string, bool, error) {
+func (im *Image) buildNeeded(lastBuild *Build, sourceResolver *SourceResolver, builder BuilderResource, policies ...RebuildPolicy) ([]string, bool, error) {
 	if !sourceResolver.Ready() {
 		return []string{}, false, nil
 	}
@@ -33,6 +38,10 @@ func (im *Image) buildNeeded(lastBuild *Build, sourceResolver *SourceResolver, b
 		return []string{}, false, nil
 	}
 
+	if im.Spec.RunImage != nil && im.Status.RunImage == "" {
+		return []string{}, false, nil
+	}
+
 	if lastBuild == nil {
 		return []string{BuildReasonConfig}, true, nil
 	}
@@ -45,7 +54,10 @@ func (im *Image) buildNeeded(lastBuild *Build, sourceResolver *SourceResolver, b
 
 	if sourceResolver.ConfigChanged(lastBuild) ||
 		!equality.Semantic.DeepEqual(im.Spec.Build.Env, lastBuild.Spec.Env) ||
-		!equality.Semantic.DeepEqual(im.Spec.Build.Resources, lastBuild.Spec.Resources) {
+		!equality.Semantic.DeepEqual(im.Spec.Build.Resources, lastBuild.Spec.Resources) ||
+		!equality.Semantic.DeepEqual(im.Spec.ImageLabels, lastBuild.Spec.ImageLabels) ||
+		!equality.Semantic.DeepEqual(im.Spec.Platforms, lastBuild.Spec.Platforms) ||
+		!lastBuild.hasTags(im.Spec.AdditionalTags) {
 		reasons = append(reasons, BuildReasonConfig)
 	}
 
@@ -57,22 +69,26 @@ func (im *Image) buildNeeded(lastBuild *Build, sourceResolver *SourceResolver, b
 		reasons = append(reasons, BuildReasonBuildpack)
 	}
 
-	if lastBuild.Status.RunImage != "" {
+	if runImage := im.runImage(builder); lastBuild.Status.RunImage != "" && runImage != "" {
 		lastBuildRunImageRef, err := name.ParseReference(lastBuild.Status.RunImage)
 		if err != nil {
 			return reasons, false, err
 		}
 
-		builderRunImageRef, err := name.ParseReference(builder.RunImage())
+		runImageRef, err := name.ParseReference(runImage)
 		if err != nil {
 			return reasons, false, err
 		}
 
-		if lastBuildRunImageRef.Identifier() != builderRunImageRef.Identifier() {
+		if lastBuildRunImageRef.Identifier() != runImageRef.Identifier() {
 			reasons = append(reasons, BuildReasonStack)
 		}
 	}
 
+	for _, policy := range policies {
+		reasons = append(reasons, policy.RebuildReasons(im, lastBuild, builder)...)
+	}
+
 	return reasons, len(reasons) > 0, nil
 }
 
@@ -86,8 +102,13 @@ func lastBuildBuiltWithBuilderBuildpacks(builder BuilderResource, build *Build)
 	return true
 }
 
-func (im *Image) build(sourceResolver *SourceResolver, builder BuilderResource, reasons []string, nextBuildNumber int64) *Build {
+func (im *Image) build(lastBuild *Build, sourceResolver *SourceResolver, builder BuilderResource, reasons []string, nextBuildNumber int64) (*Build, error) {
 	buildNumber := strconv.Itoa(int(nextBuildNumber))
+	tags, err := im.generateTags(sourceResolver, buildNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Build{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:    im.Namespace,
@@ -99,12 +120,12 @@ func (im *Image) build(sourceResolver *SourceResolver, builder BuilderResource,
 				BuildNumberLabel: buildNumber,
 				ImageLabel:       im.Name,
 			}),
-			Annotations: map[string]string{
+			Annotations: im.buildAnnotations(map[string]string{
 				BuildReasonAnnotation: strings.Join(reasons, ","),
-			},
+			}),
 		},
 		Spec: BuildSpec{
-			Tags:           im.generateTags(buildNumber),
+			Tags:           tags,
 			Builder:        builder.BuildBuilderSpec(),
 			Env:            im.Spec.Build.Env,
 			Resources:      im.Spec.Build.Resources,
@@ -112,8 +133,61 @@ func (im *Image) build(sourceResolver *SourceResolver, builder BuilderResource,
 			Source:         sourceResolver.SourceConfig(),
 			CacheName:      im.Status.BuildCacheName,
 			LastBuild:      LastBuild{Image: im.Status.LatestImage},
+			RunImage:       im.buildRunImage(),
+			CacheImage:     im.CacheImage(),
+			ClearCache:     im.clearCacheRequested(lastBuild),
+			SigningSecret:  im.signingSecret(),
+			ImageLabels:    im.Spec.ImageLabels,
+			Platforms:      im.Spec.Platforms,
 		},
+	}, nil
+}
+
+func (im *Image) signingSecret() string {
+	if im.Spec.Signing == nil {
+		return ""
+	}
+	return im.Spec.Signing.Secret
+}
+
+// buildAnnotations carries the clear cache request of the image to every build
+// so that the request is only applied to the first build made after it.
+func (im *Image) buildAnnotations(annotations map[string]string) map[string]string {
+	if request, ok := im.Annotations[ClearCacheAnnotation]; ok {
+		annotations[ClearCacheAnnotation] = request
+	}
+	return annotations
+}
+
+// clearCacheRequested reports whether the clear cache request of the image is
+// still outstanding. A request is handled once a build clearing the cache for it
+// succeeds, so builds after a failed clearing build clear the cache again.
+func (im *Image) clearCacheRequested(lastBuild *Build) bool {
+	request := im.Annotations[ClearCacheAnnotation]
+	if lastBuild == nil || request == "" {
+		return false
+	}
+
+	if request != lastBuild.Annotations[ClearCacheAnnotation] {
+		return true
+	}
+	return lastBuild.Spec.ClearCache && !lastBuild.IsSuccess()
+}
+
+// runImage is the run image the latest build is expected to use. It is resolved
+// into the image status when the image selects its own run image.
+func (im *Image) runImage(builder BuilderResource) string {
+	if im.Spec.RunImage != nil {
+		return im.Status.RunImage
+	}
+	return builder.RunImage()
+}
+
+func (im *Image) buildRunImage() string {
+	if im.Spec.RunImage != nil {
+		return im.Status.RunImage
 	}
+	return ""
 }
 
 func (im *Image) latestForImage(build *Build) string {
@@ -124,6 +198,19 @@ func (im *Image) latestForImage(build *Build) string {
 	return latestImage
 }
 
+// latestCacheSize is the cache size measured by the last build. The previously
+// reported size is kept until a build measures the cache again.
+func (im *Image) latestCacheSize(build *Build) *resource.Quantity {
+	if !im.NeedCache() && im.Spec.RegistryCache == nil {
+		return nil
+	}
+
+	if build.IsSuccess() && build.Status.CacheSize != nil {
+		return build.Status.CacheSize
+	}
+	return im.Status.BuildCacheSize
+}
+
 func (im *Image) CacheName() string {
 	return kmeta.ChildName(im.Name, "-cache")
 }
@@ -132,6 +219,24 @@ func (im *Image) NeedCache() bool {
 	return im.Spec.CacheSize != nil
 }
 
+// CacheImage is the registry tag of the build cache. It is empty unless the
+// image uses a registry cache.
+func (im *Image) CacheImage() string {
+	if im.Spec.RegistryCache == nil {
+		return ""
+	}
+
+	if im.Spec.RegistryCache.Tag != "" {
+		return im.Spec.RegistryCache.Tag
+	}
+
+	tag, err := name.NewTag(im.Spec.Tag, name.WeakValidation)
+	if err != nil {
+		return ""
+	}
+	return tag.RegistryStr() + "/" + tag.RepositoryStr() + ":" + tag.TagStr() + "-cache"
+}
+
 func (im *Image) BuildCache() *corev1.PersistentVolumeClaim {
 	return &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
@@ -174,9 +279,25 @@ func (im *Image) SourceResolver() *SourceResolver {
 	}
 }
 
-func (im *Image) generateTags(buildNumber string) []string {
+// generateTags returns the tags of a build. The additional tags of the image
+// are pushed alongside the tags in the repository of the image tag. Tags that
+// name the same image tag, e.g. two templates that render the same tag, are
+// only included once.
+func (im *Image) generateTags(sourceResolver *SourceResolver, buildNumber string) ([]string, error) {
+	tags, err := im.repositoryTags(sourceResolver, buildNumber)
+	if err != nil || len(tags) == 0 {
+		return tags, err
+	}
+	return uniqueTags(append(tags, im.Spec.AdditionalTags...)), nil
+}
+
+func (im *Image) repositoryTags(sourceResolver *SourceResolver, buildNumber string) ([]string, error) {
+	if im.Spec.ImageTaggingStrategy == Template {
+		return im.templateTags(tagTemplateData(sourceResolver, buildNumber, time.Now()))
+	}
+
 	if im.disableAdditionalImageNames() {
-		return []string{im.Spec.Tag}
+		return []string{im.Spec.Tag}, nil
 	}
 	now := time.Now()
 
@@ -184,7 +305,7 @@ func (im *Image) generateTags(buildNumber string) []string {
 	if err != nil {
 		// We assume that if the Image Name cannot be parsed the image will not be successfully built
 		// in this case we can just ignore any additional image names
-		return nil
+		return nil, nil
 	}
 
 	tagName := tag.TagStr() + "-"
@@ -193,7 +314,25 @@ func (im *Image) generateTags(buildNumber string) []string {
 	}
 	return []string{
 		im.Spec.Tag,
-		tag.RegistryStr() + "/" + tag.RepositoryStr() + ":" + tagName + "b" + buildNumber + "." + now.Format("20060102") + "." + fmt.Sprintf("%02d%02d%02d", now.Hour(), now.Minute(), now.Second())}
+		tag.RegistryStr() + "/" + tag.RepositoryStr() + ":" + tagName + "b" + buildNumber + "." + now.Format("20060102") + "." + fmt.Sprintf("%02d%02d%02d", now.Hour(), now.Minute(), now.Second())}, nil
+}
+
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		key := tag
+		if parsed, err := name.NewTag(tag, name.WeakValidation); err == nil {
+			key = parsed.Name()
+		}
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, tag)
+	}
+	return unique
 }
 
 func (im *Image) generateBuildName(buildNumber string) string {
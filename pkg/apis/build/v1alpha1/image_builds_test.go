@@ -285,6 +285,33 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				assert.Contains(t, reasons, BuildReasonConfig)
 			})
 
+			it("true if image labels change", func() {
+				image.Spec.ImageLabels = map[string]string{"com.example.team": "payments"}
+
+				reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+				require.NoError(t, err)
+				assert.True(t, needed)
+				assert.Equal(t, []string{BuildReasonConfig}, reasons)
+			})
+
+			it("true if an additional tag is not a tag of the last build", func() {
+				image.Spec.AdditionalTags = []string{"other.registry.io/some/image"}
+
+				reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+				require.NoError(t, err)
+				assert.True(t, needed)
+				assert.Equal(t, []string{BuildReasonConfig}, reasons)
+			})
+
+			it("true if platforms change", func() {
+				image.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+				reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+				require.NoError(t, err)
+				assert.True(t, needed)
+				assert.Equal(t, []string{BuildReasonConfig}, reasons)
+			})
+
 			it("true if build env order changes and git url changes", func() {
 				build.Spec.Source.Git.URL = "old-git.com/url"
 				build.Spec.Env = []v1.EnvVar{
@@ -381,6 +408,67 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 					assert.Contains(t, reasons, BuildReasonCommit)
 				})
 			})
+
+			when("Run Image changes", func() {
+				it("true if the builder run image has a new digest", func() {
+					builder.Status.RunImage = "some.registry.io/run-image@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+
+					reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+					require.NoError(t, err)
+					assert.True(t, needed)
+					assert.Equal(t, []string{BuildReasonStack}, reasons)
+				})
+
+				when("the image selects its own run image", func() {
+					it.Before(func() {
+						image.Spec.RunImage = &ImageRunImage{Image: "some.registry.io/run-image"}
+						image.Status.RunImage = build.Status.RunImage
+					})
+
+					it("false if the resolved run image matches the last build", func() {
+						builder.Status.RunImage = "some.registry.io/builder-run-image@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+
+						reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+						require.NoError(t, err)
+						assert.False(t, needed)
+						assert.Len(t, reasons, 0)
+					})
+
+					it("true if the resolved run image has a new digest", func() {
+						image.Status.RunImage = "some.registry.io/run-image@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+
+						reasons, needed, err := image.buildNeeded(build, sourceResolver, builder)
+						require.NoError(t, err)
+						assert.True(t, needed)
+						assert.Equal(t, []string{BuildReasonStack}, reasons)
+					})
+
+					it("false if the run image has not been resolved", func() {
+						image.Status.RunImage = ""
+
+						reasons, needed, err := image.buildNeeded(nil, sourceResolver, builder)
+						require.NoError(t, err)
+						assert.False(t, needed)
+						assert.Len(t, reasons, 0)
+					})
+				})
+			})
+
+			when("rebuild policies are configured", func() {
+				it("adds the reasons of every policy", func() {
+					reasons, needed, err := image.buildNeeded(build, sourceResolver, builder, testRebuildPolicy{BuildReasonSecurity}, testRebuildPolicy{})
+					require.NoError(t, err)
+					assert.True(t, needed)
+					assert.Equal(t, []string{BuildReasonSecurity}, reasons)
+				})
+
+				it("does not consult policies before the first build", func() {
+					reasons, needed, err := image.buildNeeded(nil, sourceResolver, builder, testRebuildPolicy{BuildReasonSecurity})
+					require.NoError(t, err)
+					assert.True(t, needed)
+					assert.Equal(t, []string{BuildReasonConfig}, reasons)
+				})
+			})
 		})
 
 		when("Blob", func() {
@@ -473,7 +561,8 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		it("generates a build name with build number", func() {
 			image.Name = "imageName"
 
-			build := image.build(sourceResolver, builder, []string{}, 27)
+			build, err := image.build(nil, sourceResolver, builder, []string{}, 27)
+			require.NoError(t, err)
 
 			assert.Contains(t, build.GenerateName, "imageName-build-27-")
 		})
@@ -481,13 +570,15 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		it("sets builder to be the Builder's resolved latestImage", func() {
 			image.Name = "imageName"
 
-			build := image.build(sourceResolver, builder, []string{}, 27)
+			build, err := image.build(nil, sourceResolver, builder, []string{}, 27)
+			require.NoError(t, err)
 
 			assert.Equal(t, builder.Status.LatestImage, build.Spec.Builder.Image)
 		})
 
 		it("sets git url and git revision when image source is git", func() {
-			build := image.build(sourceResolver, builder, []string{}, 27)
+			build, err := image.build(nil, sourceResolver, builder, []string{}, 27)
+			require.NoError(t, err)
 
 			assert.Contains(t, build.Spec.Source.Git.URL, "https://some.git/url")
 			assert.Contains(t, build.Spec.Source.Git.Revision, "revision")
@@ -501,7 +592,8 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 					URL: "https://some.place/blob.jar",
 				},
 			}
-			build := image.build(sourceResolver, builder, []string{}, 27)
+			build, err := image.build(nil, sourceResolver, builder, []string{}, 27)
+			require.NoError(t, err)
 
 			assert.Nil(t, build.Spec.Source.Git)
 			assert.Nil(t, build.Spec.Source.Registry)
@@ -514,7 +606,8 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 					Image: "some-registry.io/some-image",
 				},
 			}
-			build := image.build(sourceResolver, builder, []string{}, 27)
+			build, err := image.build(nil, sourceResolver, builder, []string{}, 27)
+			require.NoError(t, err)
 
 			assert.Nil(t, build.Spec.Source.Git)
 			assert.Nil(t, build.Spec.Source.Blob)
@@ -524,21 +617,26 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		it("with excludes additional tags names when explicitly disabled", func() {
 			image.Spec.Tag = "imagename/foo:test"
 			image.Spec.ImageTaggingStrategy = None
-			build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
 			require.Len(t, build.Spec.Tags, 1)
 		})
 
 		when("generates additional image names for a provided build number", func() {
 			it("with tag prefix if image name has a tag", func() {
 				image.Spec.Tag = "gcr.io/imagename/foo:test"
-				build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 45)
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 45)
+				require.NoError(t, err)
+
 				require.Len(t, build.Spec.Tags, 2)
 				require.Regexp(t, "gcr.io/imagename/foo:test-b45\\.\\d{8}\\.\\d{6}", build.Spec.Tags[1])
 			})
 
 			it("without tag prefix if image name has no provided tag", func() {
 				image.Spec.Tag = "gcr.io/imagename/notags"
-				build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
 
 				require.Len(t, build.Spec.Tags, 2)
 				require.Regexp(t, "gcr.io/imagename/notags:b1\\.\\d{8}\\.\\d{6}", build.Spec.Tags[1])
@@ -546,30 +644,150 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 
 			it("without tag prefix if image name has the tag 'latest' provided", func() {
 				image.Spec.Tag = "gcr.io/imagename/tagged:latest"
-				build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
 
 				require.Len(t, build.Spec.Tags, 2)
 				require.Regexp(t, "gcr.io/imagename/tagged:b1\\.\\d{8}\\.\\d{6}", build.Spec.Tags[1])
 			})
 		})
 
+		when("tagging strategy is template", func() {
+			it.Before(func() {
+				image.Spec.Tag = "gcr.io/imagename/myapp:latest"
+				image.Spec.ImageTaggingStrategy = Template
+				sourceResolver.Spec.Source = SourceConfig{
+					Git: &Git{
+						URL:      "https://github.com/some/app",
+						Revision: "main",
+					},
+				}
+				sourceResolver.Status.Source = ResolvedSourceConfig{
+					Git: &ResolvedGitSource{
+						URL:      "https://github.com/some/app",
+						Revision: "3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f",
+						Type:     Branch,
+					},
+				}
+			})
+
+			it("adds a tag for every template evaluated against the source and build", func() {
+				image.Spec.TagTemplates = []string{"{{.Branch}}-{{.GitShortSHA}}", "b{{.BuildNumber}}-{{.Date}}", "{{.GitSHA}}"}
+
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 12)
+				require.NoError(t, err)
+
+				require.Len(t, build.Spec.Tags, 4)
+				assert.Equal(t, "gcr.io/imagename/myapp:latest", build.Spec.Tags[0])
+				assert.Equal(t, "gcr.io/imagename/myapp:main-3f2a1c9", build.Spec.Tags[1])
+				assert.Regexp(t, "^gcr.io/imagename/myapp:b12-\\d{8}$", build.Spec.Tags[2])
+				assert.Equal(t, "gcr.io/imagename/myapp:3f2a1c9d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f", build.Spec.Tags[3])
+			})
+
+			it("replaces characters that are not valid in a tag", func() {
+				sourceResolver.Spec.Source.Git.Revision = "feature/new-thing"
+				image.Spec.TagTemplates = []string{"{{.Branch}}-{{.GitShortSHA}}"}
+
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{
+					"gcr.io/imagename/myapp:latest",
+					"gcr.io/imagename/myapp:feature-new-thing-3f2a1c9",
+				}, build.Spec.Tags)
+			})
+
+			it("only includes a tag once when templates render the same tag", func() {
+				image.Spec.TagTemplates = []string{"latest", "{{.GitShortSHA}}", "{{slice .GitSHA 0 7}}"}
+				image.Spec.AdditionalTags = []string{"gcr.io/imagename/myapp:3f2a1c9", "other.registry.io/myapp:latest"}
+
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{
+					"gcr.io/imagename/myapp:latest",
+					"gcr.io/imagename/myapp:3f2a1c9",
+					"other.registry.io/myapp:latest",
+				}, build.Spec.Tags)
+			})
+
+			it("returns an error when a template cannot be rendered", func() {
+				image.Spec.TagTemplates = []string{"{{.GitShortSHA}}", "{{if .GitSHA}}{{slice .GitSHA 0 50}}{{end}}"}
+
+				_, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.Error(t, err)
+
+				tagTemplateErr, ok := err.(*TagTemplateError)
+				require.True(t, ok)
+				assert.Equal(t, "{{if .GitSHA}}{{slice .GitSHA 0 50}}{{end}}", tagTemplateErr.Template)
+			})
+
+			it("does not provide a branch when the revision is a commit", func() {
+				sourceResolver.Status.Source.Git.Type = Commit
+				image.Spec.TagTemplates = []string{"{{.Branch}}", "{{.GitShortSHA}}"}
+
+				build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{
+					"gcr.io/imagename/myapp:latest",
+					"gcr.io/imagename/myapp:3f2a1c9",
+				}, build.Spec.Tags)
+			})
+		})
+
+		it("adds the additional tags after the tags in the image repository", func() {
+			image.Spec.Tag = "gcr.io/imagename/myapp"
+			image.Spec.AdditionalTags = []string{"eu.gcr.io/imagename/myapp", "other.registry.io/myapp:latest"}
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 3)
+			require.NoError(t, err)
+
+			require.Len(t, build.Spec.Tags, 4)
+			assert.Equal(t, "gcr.io/imagename/myapp", build.Spec.Tags[0])
+			assert.Regexp(t, "gcr.io/imagename/myapp:b3\\.\\d{8}\\.\\d{6}", build.Spec.Tags[1])
+			assert.Equal(t, "eu.gcr.io/imagename/myapp", build.Spec.Tags[2])
+			assert.Equal(t, "other.registry.io/myapp:latest", build.Spec.Tags[3])
+		})
+
 		it("generates a build name less than 64 characters", func() {
 			image.Name = "long-image-name-1234567890-1234567890-1234567890-1234567890-1234567890"
 
-			build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
 
 			assert.True(t, len(build.Name) < 64, "expected %s to be less than 64", build.Name)
 			assert.True(t, len(build.Name) < 64, "expected %s to be less than 64", build.Name)
 		})
 
 		it("adds the env vars to the build spec", func() {
-			build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
 
 			assert.Equal(t, image.Spec.Build.Env, build.Spec.Env)
 		})
 
+		it("adds the image labels to the build spec", func() {
+			image.Spec.ImageLabels = map[string]string{"com.example.team": "payments"}
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, image.Spec.ImageLabels, build.Spec.ImageLabels)
+		})
+
+		it("adds the platforms to the build spec", func() {
+			image.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, image.Spec.Platforms, build.Spec.Platforms)
+		})
+
 		it("adds build reasons annotation", func() {
-			build := image.build(sourceResolver, builder, []string{BuildReasonConfig, BuildReasonCommit}, 1)
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig, BuildReasonCommit}, 1)
+			require.NoError(t, err)
 
 			assert.Equal(t, "CONFIG,COMMIT", build.Annotations[BuildReasonAnnotation])
 		})
@@ -586,9 +804,174 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				},
 			}
 
-			build := image.build(sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
 
 			assert.Equal(t, image.Spec.Build.Resources, build.Spec.Resources)
 		})
+
+		it("does not set a run image when the image uses the builder run image", func() {
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "", build.Spec.RunImage)
+		})
+
+		it("sets the resolved run image when the image selects its own run image", func() {
+			image.Spec.RunImage = &ImageRunImage{Stack: "some-stack"}
+			image.Status.RunImage = "some.registry.io/run-image@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonStack}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, image.Status.RunImage, build.Spec.RunImage)
+		})
+
+		it("does not set a cache image without a registry cache", func() {
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "", build.Spec.CacheImage)
+		})
+
+		it("defaults the cache image to the image tag with a cache suffix", func() {
+			image.Spec.Tag = "some.registry.io/some-image:v1"
+			image.Spec.RegistryCache = &ImageRegistryCache{}
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "some.registry.io/some-image:v1-cache", build.Spec.CacheImage)
+		})
+
+		it("uses the configured registry cache tag", func() {
+			image.Spec.RegistryCache = &ImageRegistryCache{Tag: "some.registry.io/some-cache:latest"}
+
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "some.registry.io/some-cache:latest", build.Spec.CacheImage)
+		})
+
+		it("sets the signing secret when signing is configured", func() {
+			build, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "", build.Spec.SigningSecret)
+
+			image.Spec.Signing = &ImageSigning{Secret: "signing-key"}
+
+			build, err = image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+			require.NoError(t, err)
+
+			assert.Equal(t, "signing-key", build.Spec.SigningSecret)
+		})
+
+		when("the image requests to clear the cache", func() {
+			it.Before(func() {
+				image.Annotations = map[string]string{ClearCacheAnnotation: "2019-10-21T10:00:00Z"}
+			})
+
+			it("clears the cache of the next build and records the request", func() {
+				nextBuild, err := image.build(build, sourceResolver, builder, []string{BuildReasonConfig}, 2)
+				require.NoError(t, err)
+
+				assert.True(t, nextBuild.Spec.ClearCache)
+				assert.Equal(t, "2019-10-21T10:00:00Z", nextBuild.Annotations[ClearCacheAnnotation])
+			})
+
+			it("does not clear the cache again once a build handled the request", func() {
+				build.Annotations = map[string]string{ClearCacheAnnotation: "2019-10-21T10:00:00Z"}
+
+				nextBuild, err := image.build(build, sourceResolver, builder, []string{BuildReasonConfig}, 2)
+				require.NoError(t, err)
+
+				assert.False(t, nextBuild.Spec.ClearCache)
+				assert.Equal(t, "2019-10-21T10:00:00Z", nextBuild.Annotations[ClearCacheAnnotation])
+			})
+
+			it("clears the cache again when the build clearing it did not succeed", func() {
+				build.Annotations = map[string]string{ClearCacheAnnotation: "2019-10-21T10:00:00Z"}
+				build.Spec.ClearCache = true
+				build.Status.Conditions = duckv1alpha1.Conditions{
+					{
+						Type:   duckv1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionFalse,
+					},
+				}
+
+				nextBuild, err := image.build(build, sourceResolver, builder, []string{BuildReasonConfig}, 2)
+				require.NoError(t, err)
+
+				assert.True(t, nextBuild.Spec.ClearCache)
+			})
+
+			it("does not clear the cache again once the build clearing it succeeded", func() {
+				build.Annotations = map[string]string{ClearCacheAnnotation: "2019-10-21T10:00:00Z"}
+				build.Spec.ClearCache = true
+				build.Status.Conditions = duckv1alpha1.Conditions{
+					{
+						Type:   duckv1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					},
+				}
+
+				nextBuild, err := image.build(build, sourceResolver, builder, []string{BuildReasonConfig}, 2)
+				require.NoError(t, err)
+
+				assert.False(t, nextBuild.Spec.ClearCache)
+			})
+
+			it("does not clear the cache of the first build", func() {
+				firstBuild, err := image.build(nil, sourceResolver, builder, []string{BuildReasonConfig}, 1)
+				require.NoError(t, err)
+
+				assert.False(t, firstBuild.Spec.ClearCache)
+			})
+		})
 	})
+
+	when("#latestCacheSize", func() {
+		cacheSize := resource.MustParse("1G")
+		measuredSize := resource.MustParse("250Mi")
+		reportedSize := resource.MustParse("100Mi")
+
+		it.Before(func() {
+			image.Spec.CacheSize = &cacheSize
+			image.Status.BuildCacheSize = &reportedSize
+			build.Status.CacheSize = &measuredSize
+			build.Status.Conditions = duckv1alpha1.Conditions{
+				{
+					Type:   duckv1alpha1.ConditionSucceeded,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		})
+
+		it("reports the cache size measured by a successful build", func() {
+			assert.Equal(t, &measuredSize, image.latestCacheSize(build))
+		})
+
+		it("keeps the reported cache size when the build did not succeed", func() {
+			build.Status.Conditions[0].Status = corev1.ConditionFalse
+
+			assert.Equal(t, &reportedSize, image.latestCacheSize(build))
+		})
+
+		it("keeps the reported cache size when there is no build", func() {
+			assert.Equal(t, &reportedSize, image.latestCacheSize(nil))
+		})
+
+		it("reports no cache size when the image has no cache", func() {
+			image.Spec.CacheSize = nil
+
+			assert.Nil(t, image.latestCacheSize(build))
+		})
+	})
+}
+
+type testRebuildPolicy []string
+
+func (p testRebuildPolicy) RebuildReasons(*Image, *Build, BuilderResource) []string {
+	return p
 }
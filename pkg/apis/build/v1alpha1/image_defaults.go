@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/config"
+)
+
+const (
+	defaultServiceAccount = "default"
+
+	DefaultBuildHistoryLimit int64 = 10
+)
+
+func (im *Image) SetDefaults(ctx context.Context) {
+	im.Spec.SetDefaults(ctx)
+}
+
+func (is *ImageSpec) SetDefaults(ctx context.Context) {
+	if is.ServiceAccount == "" {
+		is.ServiceAccount = defaultServiceAccount
+	}
+
+	if is.Builder.Kind == "" {
+		is.Builder.Kind = BuilderKind
+	}
+
+	if is.FailedBuildHistoryLimit == nil {
+		limit := DefaultBuildHistoryLimit
+		is.FailedBuildHistoryLimit = &limit
+	}
+
+	if is.SuccessBuildHistoryLimit == nil {
+		limit := DefaultBuildHistoryLimit
+		is.SuccessBuildHistoryLimit = &limit
+	}
+
+	if is.ImageTaggingStrategy == "" && len(is.TagTemplates) > 0 {
+		is.ImageTaggingStrategy = Template
+	}
+
+	if is.ImageTaggingStrategy == "" {
+		is.ImageTaggingStrategy = BuildNumber
+	}
+
+	// the configured default only applies to new images so that changing it
+	// or removing the cache size of an existing image does not resize its cache
+	if apis.IsInCreate(ctx) && is.CacheSize == nil && is.RegistryCache == nil {
+		if cacheSize := config.FromContextOrDefaults(ctx).Defaults.CacheSize; cacheSize != nil {
+			size := cacheSize.DeepCopy()
+			is.CacheSize = &size
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/apis/config"
+)
+
+func TestImageDefaults(t *testing.T) {
+	spec.Run(t, "Image Defaults", testImageDefaults)
+}
+
+func testImageDefaults(t *testing.T, when spec.G, it spec.S) {
+	image := &v1alpha1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-name",
+		},
+		Spec: v1alpha1.ImageSpec{
+			Tag: "some/image",
+			Builder: v1alpha1.ImageBuilder{
+				Name: "some-builder",
+			},
+		},
+	}
+
+	when("#SetDefaults", func() {
+		it("defaults the service account, builder kind, history limits and tagging strategy", func() {
+			image.SetDefaults(context.TODO())
+
+			assert.Equal(t, "default", image.Spec.ServiceAccount)
+			assert.Equal(t, v1alpha1.BuilderKind, image.Spec.Builder.Kind)
+			assert.Equal(t, int64(10), *image.Spec.FailedBuildHistoryLimit)
+			assert.Equal(t, int64(10), *image.Spec.SuccessBuildHistoryLimit)
+			assert.Equal(t, v1alpha1.BuildNumber, image.Spec.ImageTaggingStrategy)
+			assert.Nil(t, image.Spec.CacheSize)
+		})
+
+		it("defaults the tagging strategy to template when tag templates are provided", func() {
+			image.Spec.TagTemplates = []string{"{{.Branch}}-{{.GitShortSHA}}"}
+
+			image.SetDefaults(context.TODO())
+
+			assert.Equal(t, v1alpha1.Template, image.Spec.ImageTaggingStrategy)
+		})
+
+		it("does not override provided values", func() {
+			failedLimit := int64(3)
+			successLimit := int64(4)
+			cacheSize := resource.MustParse("1G")
+			image.Spec.ServiceAccount = "some-service-account"
+			image.Spec.Builder.Kind = v1alpha1.ClusterBuilderKind
+			image.Spec.FailedBuildHistoryLimit = &failedLimit
+			image.Spec.SuccessBuildHistoryLimit = &successLimit
+			image.Spec.ImageTaggingStrategy = v1alpha1.None
+			image.Spec.CacheSize = &cacheSize
+
+			image.SetDefaults(withDefaultCacheSize("5G"))
+
+			assert.Equal(t, "some-service-account", image.Spec.ServiceAccount)
+			assert.Equal(t, v1alpha1.ClusterBuilderKind, image.Spec.Builder.Kind)
+			assert.Equal(t, int64(3), *image.Spec.FailedBuildHistoryLimit)
+			assert.Equal(t, int64(4), *image.Spec.SuccessBuildHistoryLimit)
+			assert.Equal(t, v1alpha1.None, image.Spec.ImageTaggingStrategy)
+			assert.Equal(t, resource.MustParse("1G"), *image.Spec.CacheSize)
+		})
+
+		it("defaults the cache size from the cluster configuration", func() {
+			image.SetDefaults(apis.WithinCreate(withDefaultCacheSize("2G")))
+
+			assert.Equal(t, resource.MustParse("2G"), *image.Spec.CacheSize)
+		})
+
+		it("does not default the cache size when a registry cache is used", func() {
+			image.Spec.RegistryCache = &v1alpha1.ImageRegistryCache{}
+
+			image.SetDefaults(apis.WithinCreate(withDefaultCacheSize("2G")))
+
+			assert.Nil(t, image.Spec.CacheSize)
+		})
+
+		it("does not default the cache size of existing images", func() {
+			image.SetDefaults(apis.WithinUpdate(withDefaultCacheSize("2G"), image.DeepCopy()))
+
+			assert.Nil(t, image.Spec.CacheSize)
+		})
+	})
+}
+
+func withDefaultCacheSize(size string) context.Context {
+	cacheSize := resource.MustParse(size)
+	return config.ToContext(context.TODO(), &config.Config{
+		Defaults: &config.Defaults{CacheSize: &cacheSize},
+	})
+}
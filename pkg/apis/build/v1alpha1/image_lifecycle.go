@@ -12,6 +12,11 @@ import (
 const (
 	BuilderNotFound = "BuilderNotFound"
 	BuilderNotReady = "BuilderNotReady"
+	StackNotFound   = "StackNotFound"
+	StackNotReady   = "StackNotReady"
+
+	RegistryAccessDenied = "RegistryAccessDenied"
+	TagTemplateFailed    = "TagTemplateFailed"
 )
 
 func (im *Image) BuilderNotFound() duckv1alpha1.Conditions {
@@ -25,3 +30,84 @@ func (im *Image) BuilderNotFound() duckv1alpha1.Conditions {
 		},
 	}
 }
+
+func (im *Image) StackNotFound() duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             StackNotFound,
+			Message:            fmt.Sprintf("Unable to find stack %s.", im.Spec.RunImage.Stack),
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+func (im *Image) StackNotReady() duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             StackNotReady,
+			Message:            fmt.Sprintf("Stack %s is not ready.", im.Spec.RunImage.Stack),
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+// TagTemplateFailed reports that no build is scheduled because a tag template
+// of the image could not be rendered for it.
+func (im *Image) TagTemplateFailed(err *TagTemplateError) duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             TagTemplateFailed,
+			Message:            fmt.Sprintf("Unable to render tag template %s: %s.", err.Template, err.Err),
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+// RegistryAccessDenied reports that no builds are scheduled because the
+// service account of the image cannot push to the tag.
+func (im *Image) RegistryAccessDenied(tag string) duckv1alpha1.Conditions {
+	message := fmt.Sprintf("Service account %s cannot push to %s.", im.Spec.ServiceAccount, tag)
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             RegistryAccessDenied,
+			Message:            message,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+		{
+			Type:               ConditionRegistryAccessReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             RegistryAccessDenied,
+			Message:            message,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+func (im *Image) RegistryAccessReady() duckv1alpha1.Condition {
+	return duckv1alpha1.Condition{
+		Type:               ConditionRegistryAccessReady,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+	}
+}
+
+// RegistryAccessVerified reports whether write access was verified for the
+// current spec of the image.
+func (im *Image) RegistryAccessVerified() bool {
+	return im.Status.ObservedGeneration == im.Generation &&
+		im.Status.GetCondition(ConditionRegistryAccessReady).IsTrue()
+}
+
+// DestinationTags returns the first tag of every repository builds of the
+// image are pushed to.
+func (im *Image) DestinationTags() []string {
+	return destinationTags(append([]string{im.Spec.Tag}, im.Spec.AdditionalTags...))
+}
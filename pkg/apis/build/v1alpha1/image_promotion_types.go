@@ -0,0 +1,62 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const ImagePromotionKind = "ImagePromotion"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type ImagePromotion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImagePromotionSpec   `json:"spec"`
+	Status ImagePromotionStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*ImagePromotion)(nil)
+	_ apis.Defaultable = (*ImagePromotion)(nil)
+)
+
+// ImagePromotionSpec copies the image of an Image or a Build to another tag.
+// Promotions of an Image follow its latest image, promotions of a Build copy
+// the image of that build only.
+type ImagePromotionSpec struct {
+	Image string `json:"image,omitempty"`
+	Build string `json:"build,omitempty"`
+	Tag   string `json:"tag"`
+	// ServiceAccount is used to pull and push the image. Defaults to the
+	// service account of the promoted Image or Build.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+type ImagePromotionStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	SourceImage         string `json:"sourceImage,omitempty"`
+	PromotedImage       string `json:"promotedImage,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePromotionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ImagePromotion `json:"items"`
+}
+
+func (*ImagePromotion) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(ImagePromotionKind)
+}
+
+func (p *ImagePromotion) NamespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: p.Namespace, Name: p.Name}
+}
@@ -0,0 +1,31 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (p *ImagePromotion) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return p.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ps *ImagePromotionSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateTag(ps.Tag).
+		Also(ps.validateSource())
+}
+
+func (ps *ImagePromotionSpec) validateSource() *apis.FieldError {
+	if ps.Image == "" && ps.Build == "" {
+		return apis.ErrMissingOneOf("image", "build")
+	}
+
+	if ps.Image != "" && ps.Build != "" {
+		return apis.ErrMultipleOneOf("image", "build")
+	}
+	return nil
+}
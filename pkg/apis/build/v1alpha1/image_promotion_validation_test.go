@@ -0,0 +1,62 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestImagePromotionValidation(t *testing.T) {
+	spec.Run(t, "Image Promotion Validation", testImagePromotionValidation)
+}
+
+func testImagePromotionValidation(t *testing.T, when spec.G, it spec.S) {
+	promotion := &v1alpha1.ImagePromotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "promotion-name",
+		},
+		Spec: v1alpha1.ImagePromotionSpec{
+			Image: "image-name",
+			Tag:   "prod.registry.io/app:latest",
+		},
+	}
+
+	it("returns nil on no validation error", func() {
+		assert.Nil(t, promotion.Validate(context.TODO()))
+
+		promotion.Spec.Image = ""
+		promotion.Spec.Build = "build-name"
+		assert.Nil(t, promotion.Validate(context.TODO()))
+	})
+
+	it("missing tag", func() {
+		promotion.Spec.Tag = ""
+		assert.EqualError(t, promotion.Validate(context.TODO()), apis.ErrMissingField("tag").ViaField("spec").Error())
+	})
+
+	it("invalid tag", func() {
+		promotion.Spec.Tag = "ftp//invalid/tag@@"
+		assert.EqualError(t, promotion.Validate(context.TODO()), apis.ErrInvalidValue("ftp//invalid/tag@@", "tag").ViaField("spec").Error())
+	})
+
+	it("missing image and build", func() {
+		promotion.Spec.Image = ""
+		assert.EqualError(t, promotion.Validate(context.TODO()), apis.ErrMissingOneOf("image", "build").ViaField("spec").Error())
+	})
+
+	it("image and build", func() {
+		promotion.Spec.Build = "build-name"
+		assert.EqualError(t, promotion.Validate(context.TODO()), apis.ErrMultipleOneOf("image", "build").ViaField("spec").Error())
+	})
+
+	it("skips validation on status update", func() {
+		promotion.Spec = v1alpha1.ImagePromotionSpec{}
+		assert.Nil(t, promotion.Validate(apis.WithinSubResourceUpdate(context.TODO(), promotion, "status")))
+	})
+}
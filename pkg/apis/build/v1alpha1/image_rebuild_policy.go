@@ -0,0 +1,176 @@
+package v1alpha1
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	BuildReasonSecurity = "SECURITY"
+
+	// VulnerabilitiesAnnotation lists vulnerable dependencies of an image as
+	// comma separated name@version pairs. Names are either a buildpack id or the
+	// name of a dependency in the bill of materials. It is maintained by an
+	// external scanner.
+	VulnerabilitiesAnnotation = "image.build.pivotal.io/vulnerabilities"
+)
+
+// RebuildPolicy requests builds for reasons that are not part of the image
+// configuration. A policy is only consulted once the image has been built and
+// its source resolver and builder are ready.
+type RebuildPolicy interface {
+	RebuildReasons(image *Image, lastBuild *Build, builder BuilderResource) []string
+}
+
+// VulnerabilityRebuildPolicy rebuilds images whose last build contains a
+// vulnerable dependency as soon as the builder offers a greater version of the
+// buildpack that provided it.
+type VulnerabilityRebuildPolicy struct{}
+
+func (VulnerabilityRebuildPolicy) RebuildReasons(image *Image, lastBuild *Build, builder BuilderResource) []string {
+	if lastBuild.securityBuildWith(builder) {
+		return nil
+	}
+
+	for _, vulnerability := range vulnerabilities(image) {
+		for _, bp := range lastBuild.buildpacksProviding(vulnerability) {
+			if builderOffersGreaterVersion(builder, bp) {
+				return []string{BuildReasonSecurity}
+			}
+		}
+	}
+	return nil
+}
+
+type vulnerability struct {
+	name    string
+	version string
+}
+
+func vulnerabilities(image *Image) []vulnerability {
+	var vulnerabilities []vulnerability
+	for _, entry := range strings.Split(image.Annotations[VulnerabilitiesAnnotation], ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "@", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		vulnerabilities = append(vulnerabilities, vulnerability{name: parts[0], version: parts[1]})
+	}
+	return vulnerabilities
+}
+
+// buildpacksProviding returns the buildpacks of the build that are the
+// vulnerable version or that contributed the vulnerable dependency.
+func (b *Build) buildpacksProviding(v vulnerability) []BuildpackMetadata {
+	var buildpacks []BuildpackMetadata
+	for _, bp := range b.Status.BuildMetadata {
+		if bp.ID == v.name && bp.Version == v.version {
+			buildpacks = append(buildpacks, bp)
+		}
+	}
+
+	for _, entry := range b.Status.BOM {
+		if entry.Name == v.name && entry.Version == v.version {
+			buildpacks = append(buildpacks, BuildpackMetadata{ID: entry.Buildpack.ID, Version: entry.Buildpack.Version})
+		}
+	}
+	return buildpacks
+}
+
+// builderOffersGreaterVersion prevents security builds with a builder that only
+// offers older versions of the buildpack. Versions that cannot be compared
+// never trigger a rebuild.
+func builderOffersGreaterVersion(builder BuilderResource, bp BuildpackMetadata) bool {
+	for _, offered := range builder.BuildpackMetadata() {
+		if offered.ID != bp.ID {
+			continue
+		}
+
+		if comparison, ok := compareVersions(offered.Version, bp.Version); ok && comparison > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compareVersions compares semantic versions with an optional "v" prefix.
+// A pre-release is lower than the release of the same version and build
+// metadata is ignored.
+func compareVersions(a, b string) (int, bool) {
+	aCore, aPreRelease, ok := parseVersion(a)
+	if !ok {
+		return 0, false
+	}
+
+	bCore, bPreRelease, ok := parseVersion(b)
+	if !ok {
+		return 0, false
+	}
+
+	for i := 0; i < len(aCore) || i < len(bCore); i++ {
+		var aPart, bPart int
+		if i < len(aCore) {
+			aPart = aCore[i]
+		}
+		if i < len(bCore) {
+			bPart = bCore[i]
+		}
+
+		if aPart != bPart {
+			if aPart > bPart {
+				return 1, true
+			}
+			return -1, true
+		}
+	}
+
+	switch {
+	case aPreRelease == bPreRelease:
+		return 0, true
+	case aPreRelease == "":
+		return 1, true
+	case bPreRelease == "":
+		return -1, true
+	case aPreRelease > bPreRelease:
+		return 1, true
+	default:
+		return -1, true
+	}
+}
+
+func parseVersion(version string) ([]int, string, bool) {
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.Index(version, "+"); i >= 0 {
+		version = version[:i]
+	}
+
+	var preRelease string
+	if i := strings.Index(version, "-"); i >= 0 {
+		version, preRelease = version[:i], version[i+1:]
+	}
+
+	var core []int
+	for _, part := range strings.Split(version, ".") {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return nil, "", false
+		}
+		core = append(core, number)
+	}
+	return core, preRelease, true
+}
+
+// securityBuildWith prevents repeated security builds when the builder still
+// selects the vulnerable buildpack version.
+func (b *Build) securityBuildWith(builder BuilderResource) bool {
+	if b.Spec.Builder.Image != builder.BuildBuilderSpec().Image {
+		return false
+	}
+
+	for _, reason := range strings.Split(b.Annotations[BuildReasonAnnotation], ",") {
+		if reason == BuildReasonSecurity {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestVulnerabilityRebuildPolicy(t *testing.T) {
+	spec.Run(t, "Vulnerability Rebuild Policy", testVulnerabilityRebuildPolicy)
+}
+
+func testVulnerabilityRebuildPolicy(t *testing.T, when spec.G, it spec.S) {
+	policy := VulnerabilityRebuildPolicy{}
+
+	image := &Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-name",
+			Annotations: map[string]string{
+				VulnerabilitiesAnnotation: "openssl@1.1.1c, io.buildpacks.vulnerable@1.0.0",
+			},
+		},
+	}
+
+	builder := &Builder{
+		Status: BuilderStatus{
+			BuilderMetadata: []BuildpackMetadata{
+				{ID: "io.buildpacks.node", Version: "1.0.0"},
+				{ID: "io.buildpacks.other", Version: "1.0.0"},
+			},
+			LatestImage: "some/builder@sha256:builder-digest",
+		},
+	}
+
+	build := &Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-name-build-1",
+			Annotations: map[string]string{
+				BuildReasonAnnotation: BuildReasonCommit,
+			},
+		},
+		Spec: BuildSpec{
+			Builder: builder.BuildBuilderSpec(),
+		},
+		Status: BuildStatus{
+			BuildMetadata: []BuildpackMetadata{
+				{ID: "io.buildpacks.node", Version: "1.0.0"},
+			},
+			BOM: []BOMEntry{
+				{
+					Name:      "openssl",
+					Version:   "1.1.1c",
+					Buildpack: BOMBuildpack{ID: "io.buildpacks.node", Version: "1.0.0"},
+				},
+			},
+		},
+	}
+
+	it("does not rebuild while the builder only offers the buildpack version that provided the vulnerable dependency", func() {
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("rebuilds when the builder offers a greater version of the buildpack that provided the vulnerable dependency", func() {
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "1.0.1"})
+
+		assert.Equal(t, []string{BuildReasonSecurity}, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("rebuilds when the builder offers a greater version of a vulnerable buildpack", func() {
+		build.Status.BuildMetadata = append(build.Status.BuildMetadata, BuildpackMetadata{ID: "io.buildpacks.vulnerable", Version: "1.0.0"})
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.vulnerable", Version: "2.0.0"})
+
+		assert.Equal(t, []string{BuildReasonSecurity}, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("does not rebuild when the builder only offers lower versions of the buildpack", func() {
+		build.Status.BuildMetadata[0].Version = "v1.2.0"
+		build.Status.BOM[0].Buildpack.Version = "v1.2.0"
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata,
+			BuildpackMetadata{ID: "io.buildpacks.node", Version: "v1.1.9"},
+			BuildpackMetadata{ID: "io.buildpacks.node", Version: "v1.2.0-rc.1"},
+		)
+
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("compares the versions numerically", func() {
+		build.Status.BuildMetadata[0].Version = "v1.0.9"
+		build.Status.BOM[0].Buildpack.Version = "v1.0.9"
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "v1.0.10"})
+
+		assert.Equal(t, []string{BuildReasonSecurity}, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("does not rebuild for versions that cannot be compared", func() {
+		build.Status.BuildMetadata[0].Version = "latest"
+		build.Status.BOM[0].Buildpack.Version = "latest"
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "1.0.1"})
+
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("does not rebuild when the build does not contain the vulnerable version", func() {
+		build.Status.BOM[0].Version = "1.1.1d"
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "1.0.1"})
+
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("does not rebuild without vulnerabilities", func() {
+		image.Annotations = map[string]string{VulnerabilitiesAnnotation: "invalid,@1.0.0,openssl@"}
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "1.0.1"})
+
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+	})
+
+	it("does not rebuild again after a security build with the same builder", func() {
+		build.Annotations[BuildReasonAnnotation] = "BUILDPACK,SECURITY"
+		builder.Status.BuilderMetadata = append(builder.Status.BuilderMetadata, BuildpackMetadata{ID: "io.buildpacks.node", Version: "1.0.1"})
+
+		assert.Empty(t, policy.RebuildReasons(image, build, builder))
+
+		builder.Status.LatestImage = "some/builder@sha256:new-builder-digest"
+
+		assert.Equal(t, []string{BuildReasonSecurity}, policy.RebuildReasons(image, build, builder))
+	})
+}
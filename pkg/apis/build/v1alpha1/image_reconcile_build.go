@@ -5,27 +5,35 @@ import (
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 )
 
-func (im *Image) ReconcileBuild(latestBuild *Build, resolver *SourceResolver, builder BuilderResource) (BuildApplier, error) {
+func (im *Image) ReconcileBuild(latestBuild *Build, resolver *SourceResolver, builder BuilderResource, policies ...RebuildPolicy) (BuildApplier, error) {
 	currentBuildNumber, err := buildCounter(latestBuild)
 	if err != nil {
 		return nil, err
 	}
 	latestImage := im.latestForImage(latestBuild)
+	cacheSize := im.latestCacheSize(latestBuild)
 
-	if reasons, needed, err := im.buildNeeded(latestBuild, resolver, builder); err != nil {
+	if reasons, needed, err := im.buildNeeded(latestBuild, resolver, builder, policies...); err != nil {
 		return nil, err
 	} else if needed {
 		nextBuildNumber := currentBuildNumber + 1
+		build, err := im.build(latestBuild, resolver, builder, reasons, nextBuildNumber)
+		if err != nil {
+			return nil, err
+		}
+
 		return newBuild{
 			previousBuild: latestBuild,
-			build:         im.build(resolver, builder, reasons, nextBuildNumber),
+			build:         build,
 			buildCounter:  nextBuildNumber,
 			latestImage:   latestImage,
+			cacheSize:     cacheSize,
 		}, nil
 	}
 
@@ -33,6 +41,7 @@ func (im *Image) ReconcileBuild(latestBuild *Build, resolver *SourceResolver, bu
 		build:        latestBuild,
 		buildCounter: currentBuildNumber,
 		latestImage:  latestImage,
+		cacheSize:    cacheSize,
 		builder:      builder,
 	}, nil
 }
@@ -45,6 +54,7 @@ type ReconciledBuild struct {
 	Build        *Build
 	BuildCounter int64
 	LatestImage  string
+	CacheSize    *resource.Quantity
 	Conditions   duckv1alpha1.Conditions
 }
 
@@ -56,6 +66,7 @@ type upToDateBuild struct {
 	build        *Build
 	buildCounter int64
 	latestImage  string
+	cacheSize    *resource.Quantity
 	builder      BuilderResource
 }
 
@@ -64,6 +75,7 @@ func (r upToDateBuild) Apply(creator BuildCreator) (ReconciledBuild, error) {
 		Build:        r.build,
 		BuildCounter: r.buildCounter,
 		LatestImage:  r.latestImage,
+		CacheSize:    r.cacheSize,
 		Conditions:   r.conditions(),
 	}, nil
 }
@@ -85,11 +97,20 @@ func (r upToDateBuild) conditions() duckv1alpha1.Conditions {
 		{
 			Type:               duckv1alpha1.ConditionReady,
 			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            r.buildMessage(condition),
 			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
 		}, r.builderCondition(),
 	}
 }
 
+func (r upToDateBuild) buildMessage(condition *duckv1alpha1.Condition) string {
+	if !condition.IsFalse() || condition.Message == "" {
+		return condition.Message
+	}
+	return fmt.Sprintf("Build %s failed: %s", r.build.Name, condition.Message)
+}
+
 func (r upToDateBuild) builderCondition() duckv1alpha1.Condition {
 	if !r.builder.Ready() {
 		return duckv1alpha1.Condition{
@@ -109,6 +130,7 @@ type newBuild struct {
 	build         *Build
 	buildCounter  int64
 	latestImage   string
+	cacheSize     *resource.Quantity
 	previousBuild *Build
 }
 
@@ -118,6 +140,7 @@ func (r newBuild) Apply(creator BuildCreator) (ReconciledBuild, error) {
 		Build:        build,
 		BuildCounter: r.buildCounter,
 		LatestImage:  r.latestImage,
+		CacheSize:    r.cacheSize,
 		Conditions:   r.conditions(),
 	}, err
 }
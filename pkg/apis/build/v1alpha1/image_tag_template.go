@@ -0,0 +1,134 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"knative.dev/pkg/apis"
+)
+
+const maxTagLength = 128
+
+var invalidTagCharacters = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// parsedTagTemplates caches the parsed tag templates by their text so that
+// templates are not parsed again for every build.
+var parsedTagTemplates sync.Map
+
+// TagTemplateError reports a tag template that could not be rendered for a
+// build, e.g. a template that slices a field beyond its length.
+type TagTemplateError struct {
+	Template string
+	Err      error
+}
+
+func (e *TagTemplateError) Error() string {
+	return fmt.Sprintf("tag template %q failed: %s", e.Template, e.Err)
+}
+
+// TagTemplateData is available to the TagTemplates of an Image.
+type TagTemplateData struct {
+	// GitSHA is the resolved git commit of the build. Empty for blob and registry sources.
+	GitSHA string
+	// GitShortSHA is the first seven characters of GitSHA.
+	GitShortSHA string
+	// Branch is the git branch of the source. Empty unless the source revision is a branch.
+	Branch string
+	// BuildNumber is the number of the build of the Image.
+	BuildNumber string
+	// Date is the date the build was created formatted as YYYYMMDD.
+	Date string
+}
+
+func tagTemplateData(sourceResolver *SourceResolver, buildNumber string, now time.Time) TagTemplateData {
+	data := TagTemplateData{
+		BuildNumber: buildNumber,
+		Date:        now.Format("20060102"),
+	}
+
+	if git := sourceResolver.Status.Source.Git; git != nil {
+		data.GitSHA = git.Revision
+		data.GitShortSHA = git.Revision
+		if len(data.GitShortSHA) > 7 {
+			data.GitShortSHA = data.GitShortSHA[:7]
+		}
+		if git.Type == Branch && sourceResolver.Spec.Source.Git != nil {
+			data.Branch = sourceResolver.Spec.Source.Git.Revision
+		}
+	}
+	return data
+}
+
+func (im *Image) templateTags(data TagTemplateData) ([]string, error) {
+	tags := []string{im.Spec.Tag}
+
+	tag, err := name.NewTag(im.Spec.Tag, name.WeakValidation)
+	if err != nil {
+		return tags, nil
+	}
+
+	for _, tagTemplate := range im.Spec.TagTemplates {
+		t, err := parseTagTemplate(tagTemplate)
+		if err != nil {
+			return nil, &TagTemplateError{Template: tagTemplate, Err: err}
+		}
+
+		var rendered bytes.Buffer
+		if err := t.Execute(&rendered, data); err != nil {
+			return nil, &TagTemplateError{Template: tagTemplate, Err: err}
+		}
+
+		tagName := sanitizeTag(rendered.String())
+		if tagName == "" {
+			continue
+		}
+		tags = append(tags, tag.RegistryStr()+"/"+tag.RepositoryStr()+":"+tagName)
+	}
+	return tags, nil
+}
+
+func parseTagTemplate(tagTemplate string) (*template.Template, error) {
+	if t, ok := parsedTagTemplates.Load(tagTemplate); ok {
+		return t.(*template.Template), nil
+	}
+
+	t, err := template.New("tag").Parse(tagTemplate)
+	if err != nil {
+		return nil, err
+	}
+	parsedTagTemplates.Store(tagTemplate, t)
+	return t, nil
+}
+
+// sanitizeTag replaces characters that are not allowed in a tag, such as the
+// slash of a feature/name branch, with dashes.
+func sanitizeTag(tag string) string {
+	tag = invalidTagCharacters.ReplaceAllString(tag, "-")
+	tag = strings.TrimLeft(tag, ".-")
+	if len(tag) > maxTagLength {
+		tag = tag[:maxTagLength]
+	}
+	return tag
+}
+
+func validateTagTemplates(tagTemplates []string) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, tagTemplate := range tagTemplates {
+		t, err := parseTagTemplate(tagTemplate)
+		if err != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(tagTemplate, "tagTemplates", i))
+			continue
+		}
+
+		if err := t.Execute(&bytes.Buffer{}, TagTemplateData{}); err != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(tagTemplate, "tagTemplates", i))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,180 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"knative.dev/pkg/apis"
+)
+
+func (im *Image) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return im.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (is *ImageSpec) Validate(ctx context.Context) *apis.FieldError {
+	return validateTag(is.Tag).
+		Also(is.Builder.Validate(ctx).ViaField("builder")).
+		Also(is.Source.Validate(ctx).ViaField("source")).
+		Also(is.validateCacheSize()).
+		Also(validateBuildHistoryLimit(is.FailedBuildHistoryLimit, "failedBuildHistoryLimit")).
+		Also(validateBuildHistoryLimit(is.SuccessBuildHistoryLimit, "successBuildHistoryLimit")).
+		Also(is.validateImageTaggingStrategy()).
+		Also(is.validateAdditionalTags()).
+		Also(validateImageLabels(is.ImageLabels)).
+		Also(validatePlatforms(is.Platforms)).
+		Also(is.RunImage.Validate(ctx).ViaField("runImage")).
+		Also(is.RegistryCache.Validate(ctx).ViaField("registryCache")).
+		Also(is.Signing.Validate(ctx).ViaField("signing"))
+}
+
+func (ib *ImageBuilder) Validate(ctx context.Context) *apis.FieldError {
+	if ib.Name == "" {
+		return apis.ErrMissingField("name")
+	}
+
+	switch ib.Kind {
+	case BuilderKind, ClusterBuilderKind, CustomBuilderKind:
+		return nil
+	case "":
+		return apis.ErrMissingField("kind")
+	default:
+		return apis.ErrInvalidValue(ib.Kind, "kind")
+	}
+}
+
+func (ri *ImageRunImage) Validate(ctx context.Context) *apis.FieldError {
+	if ri == nil {
+		return nil
+	}
+
+	if ri.Image == "" && ri.Stack == "" {
+		return apis.ErrMissingOneOf("image", "stack")
+	}
+
+	if ri.Image != "" && ri.Stack != "" {
+		return apis.ErrMultipleOneOf("image", "stack")
+	}
+
+	if ri.Image != "" {
+		return validateImage(ri.Image)
+	}
+	return nil
+}
+
+func (rc *ImageRegistryCache) Validate(ctx context.Context) *apis.FieldError {
+	if rc == nil || rc.Tag == "" {
+		return nil
+	}
+
+	if _, err := name.NewTag(rc.Tag, name.WeakValidation); err != nil {
+		return apis.ErrInvalidValue(rc.Tag, "tag")
+	}
+	return nil
+}
+
+func (s *ImageSigning) Validate(ctx context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+
+	if s.Secret == "" {
+		return apis.ErrMissingField("secret")
+	}
+	return nil
+}
+
+func (is *ImageSpec) validateCacheSize() *apis.FieldError {
+	if is.CacheSize != nil && is.RegistryCache != nil {
+		return apis.ErrMultipleOneOf("cacheSize", "registryCache")
+	}
+
+	if is.CacheSize != nil && is.CacheSize.Sign() <= 0 {
+		return apis.ErrInvalidValue(is.CacheSize.String(), "cacheSize")
+	}
+	return nil
+}
+
+func (is *ImageSpec) validateImageTaggingStrategy() *apis.FieldError {
+	switch is.ImageTaggingStrategy {
+	case "", None, BuildNumber:
+		if len(is.TagTemplates) > 0 {
+			return apis.ErrDisallowedFields("tagTemplates")
+		}
+		return nil
+	case Template:
+		if len(is.TagTemplates) == 0 {
+			return apis.ErrMissingField("tagTemplates")
+		}
+		return validateTagTemplates(is.TagTemplates)
+	default:
+		return apis.ErrInvalidValue(is.ImageTaggingStrategy, "imageTaggingStrategy")
+	}
+}
+
+func (is *ImageSpec) validateAdditionalTags() *apis.FieldError {
+	var errs *apis.FieldError
+	seen := map[string]bool{is.Tag: true}
+	for i, tag := range is.AdditionalTags {
+		if _, err := name.NewTag(tag, name.WeakValidation); err != nil || seen[tag] {
+			errs = errs.Also(apis.ErrInvalidArrayValue(tag, "additionalTags", i))
+		}
+		seen[tag] = true
+	}
+	return errs
+}
+
+func validateImageLabels(labels map[string]string) *apis.FieldError {
+	if _, ok := labels[""]; ok {
+		return apis.ErrInvalidKeyName("", "imageLabels", "label names must not be empty")
+	}
+	return nil
+}
+
+func validatePlatforms(platforms []string) *apis.FieldError {
+	var errs *apis.FieldError
+	seen := map[string]bool{}
+	for i, platform := range platforms {
+		if _, _, _, err := ParsePlatform(platform); err != nil || seen[platform] {
+			errs = errs.Also(apis.ErrInvalidArrayValue(platform, "platforms", i))
+		}
+		seen[platform] = true
+	}
+	return errs
+}
+
+func validateBuildHistoryLimit(limit *int64, field string) *apis.FieldError {
+	if limit != nil && *limit < 1 {
+		return apis.ErrOutOfBoundsValue(*limit, 1, "∞", field)
+	}
+	return nil
+}
+
+func validateTag(tag string) *apis.FieldError {
+	if tag == "" {
+		return apis.ErrMissingField("tag")
+	}
+
+	if _, err := name.NewTag(tag, name.WeakValidation); err != nil {
+		return apis.ErrInvalidValue(tag, "tag")
+	}
+	return nil
+}
+
+func validateImage(image string) *apis.FieldError {
+	return validateImageField(image, "image")
+}
+
+func validateImageField(image, field string) *apis.FieldError {
+	if image == "" {
+		return apis.ErrMissingField(field)
+	}
+
+	if _, err := name.ParseReference(image, name.WeakValidation); err != nil {
+		return apis.ErrInvalidValue(image, field)
+	}
+	return nil
+}
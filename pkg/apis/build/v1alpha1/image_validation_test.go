@@ -0,0 +1,213 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestImageValidation(t *testing.T) {
+	spec.Run(t, "Image Validation", testImageValidation)
+}
+
+func testImageValidation(t *testing.T, when spec.G, it spec.S) {
+	image := &v1alpha1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-name",
+		},
+		Spec: v1alpha1.ImageSpec{
+			Tag:            "some/image",
+			ServiceAccount: "some/service-account",
+			Builder: v1alpha1.ImageBuilder{
+				TypeMeta: metav1.TypeMeta{
+					Kind: v1alpha1.ClusterBuilderKind,
+				},
+				Name: "builder-name",
+			},
+			Source: v1alpha1.SourceConfig{
+				Git: &v1alpha1.Git{
+					URL:      "http://github.com/repo",
+					Revision: "master",
+				},
+			},
+		},
+	}
+
+	when("Validate", func() {
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, image.Validate(context.TODO()))
+		})
+
+		assertValidationError := func(image *v1alpha1.Image, expectedError *apis.FieldError) {
+			t.Helper()
+			err := image.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("missing field tag", func() {
+			image.Spec.Tag = ""
+			assertValidationError(image, apis.ErrMissingField("tag").ViaField("spec"))
+		})
+
+		it("invalid image tag", func() {
+			image.Spec.Tag = "ftp//invalid/tag@@"
+			assertValidationError(image, apis.ErrInvalidValue(image.Spec.Tag, "tag").ViaField("spec"))
+		})
+
+		it("missing builder name", func() {
+			image.Spec.Builder.Name = ""
+			assertValidationError(image, apis.ErrMissingField("name").ViaField("spec", "builder"))
+		})
+
+		it("missing builder kind", func() {
+			image.Spec.Builder.Kind = ""
+			assertValidationError(image, apis.ErrMissingField("kind").ViaField("spec", "builder"))
+		})
+
+		it("invalid builder kind", func() {
+			image.Spec.Builder.Kind = "FakeBuilder"
+			assertValidationError(image, apis.ErrInvalidValue("FakeBuilder", "kind").ViaField("spec", "builder"))
+		})
+
+		it("missing source", func() {
+			image.Spec.Source = v1alpha1.SourceConfig{}
+			assertValidationError(image, apis.ErrMissingOneOf("git", "blob", "registry").ViaField("spec", "source"))
+		})
+
+		it("multiple sources", func() {
+			image.Spec.Source.Blob = &v1alpha1.Blob{
+				URL: "http://blob.com/url",
+			}
+			assertValidationError(image, apis.ErrMultipleOneOf("git", "blob").ViaField("spec", "source"))
+		})
+
+		it("missing git revision", func() {
+			image.Spec.Source.Git.Revision = ""
+			assertValidationError(image, apis.ErrMissingField("revision").ViaField("spec", "source", "git"))
+		})
+
+		it("missing blob url", func() {
+			image.Spec.Source = v1alpha1.SourceConfig{
+				Blob: &v1alpha1.Blob{},
+			}
+			assertValidationError(image, apis.ErrMissingField("url").ViaField("spec", "source", "blob"))
+		})
+
+		it("invalid registry image", func() {
+			image.Spec.Source = v1alpha1.SourceConfig{
+				Registry: &v1alpha1.Registry{
+					Image: "invalid@@image",
+				},
+			}
+			assertValidationError(image, apis.ErrInvalidValue("invalid@@image", "image").ViaField("spec", "source", "registry"))
+		})
+
+		it("invalid cache size", func() {
+			cacheSize := resource.MustParse("-1Gi")
+			image.Spec.CacheSize = &cacheSize
+			assertValidationError(image, apis.ErrInvalidValue("-1Gi", "cacheSize").ViaField("spec"))
+		})
+
+		it("cache size and registry cache", func() {
+			cacheSize := resource.MustParse("1Gi")
+			image.Spec.CacheSize = &cacheSize
+			image.Spec.RegistryCache = &v1alpha1.ImageRegistryCache{}
+			assertValidationError(image, apis.ErrMultipleOneOf("cacheSize", "registryCache").ViaField("spec"))
+		})
+
+		it("invalid registry cache tag", func() {
+			image.Spec.RegistryCache = &v1alpha1.ImageRegistryCache{Tag: "ftp//invalid/tag@@"}
+			assertValidationError(image, apis.ErrInvalidValue("ftp//invalid/tag@@", "tag").ViaField("spec", "registryCache"))
+		})
+
+		it("missing signing secret", func() {
+			image.Spec.Signing = &v1alpha1.ImageSigning{}
+			assertValidationError(image, apis.ErrMissingField("secret").ViaField("spec", "signing"))
+		})
+
+		it("negative build history limits", func() {
+			failedLimit := int64(-1)
+			successLimit := int64(0)
+			image.Spec.FailedBuildHistoryLimit = &failedLimit
+			image.Spec.SuccessBuildHistoryLimit = &successLimit
+			assertValidationError(image, apis.ErrOutOfBoundsValue(int64(-1), 1, "∞", "failedBuildHistoryLimit").ViaField("spec").
+				Also(apis.ErrOutOfBoundsValue(int64(0), 1, "∞", "successBuildHistoryLimit").ViaField("spec")))
+		})
+
+		it("invalid image tagging strategy", func() {
+			image.Spec.ImageTaggingStrategy = "Sometimes"
+			assertValidationError(image, apis.ErrInvalidValue("Sometimes", "imageTaggingStrategy").ViaField("spec"))
+		})
+
+		it("missing tag templates for the template tagging strategy", func() {
+			image.Spec.ImageTaggingStrategy = v1alpha1.Template
+			assertValidationError(image, apis.ErrMissingField("tagTemplates").ViaField("spec"))
+		})
+
+		it("tag templates without the template tagging strategy", func() {
+			image.Spec.ImageTaggingStrategy = v1alpha1.BuildNumber
+			image.Spec.TagTemplates = []string{"{{.GitShortSHA}}"}
+			assertValidationError(image, apis.ErrDisallowedFields("tagTemplates").ViaField("spec"))
+		})
+
+		it("invalid tag templates", func() {
+			image.Spec.ImageTaggingStrategy = v1alpha1.Template
+			image.Spec.TagTemplates = []string{"{{.Branch}}-{{.GitShortSHA}}", "{{.Branch", "{{.Commit}}"}
+			assertValidationError(image,
+				apis.ErrInvalidArrayValue("{{.Branch", "tagTemplates", 1).
+					Also(apis.ErrInvalidArrayValue("{{.Commit}}", "tagTemplates", 2)).
+					ViaField("spec"))
+		})
+
+		it("invalid and duplicate additional tags", func() {
+			image.Spec.AdditionalTags = []string{"other.registry.io/some/image", "invalid/@@image", image.Spec.Tag, "other.registry.io/some/image"}
+			assertValidationError(image,
+				apis.ErrInvalidArrayValue("invalid/@@image", "additionalTags", 1).
+					Also(apis.ErrInvalidArrayValue(image.Spec.Tag, "additionalTags", 2)).
+					Also(apis.ErrInvalidArrayValue("other.registry.io/some/image", "additionalTags", 3)).
+					ViaField("spec"))
+		})
+
+		it("empty image label names", func() {
+			image.Spec.ImageLabels = map[string]string{"": "some-value"}
+			assertValidationError(image, apis.ErrInvalidKeyName("", "imageLabels", "label names must not be empty").ViaField("spec"))
+		})
+
+		it("invalid and duplicate platforms", func() {
+			image.Spec.Platforms = []string{"linux/amd64", "linux", "linux/arm/v7", "linux/amd64", "linux//arm64"}
+			assertValidationError(image,
+				apis.ErrInvalidArrayValue("linux", "platforms", 1).
+					Also(apis.ErrInvalidArrayValue("linux/amd64", "platforms", 3)).
+					Also(apis.ErrInvalidArrayValue("linux//arm64", "platforms", 4)).
+					ViaField("spec"))
+		})
+
+		it("missing run image and stack", func() {
+			image.Spec.RunImage = &v1alpha1.ImageRunImage{}
+			assertValidationError(image, apis.ErrMissingOneOf("image", "stack").ViaField("spec", "runImage"))
+		})
+
+		it("both run image and stack", func() {
+			image.Spec.RunImage = &v1alpha1.ImageRunImage{Image: "some/run", Stack: "some-stack"}
+			assertValidationError(image, apis.ErrMultipleOneOf("image", "stack").ViaField("spec", "runImage"))
+		})
+
+		it("invalid run image", func() {
+			image.Spec.RunImage = &v1alpha1.ImageRunImage{Image: "invalid@@image"}
+			assertValidationError(image, apis.ErrInvalidValue("invalid@@image", "image").ViaField("spec", "runImage"))
+		})
+
+		it("skips validation on status updates", func() {
+			image.Spec.Tag = ""
+			ctx := apis.WithinSubResourceUpdate(context.TODO(), image, "status")
+			assert.Nil(t, image.Validate(ctx))
+		})
+	})
+}
@@ -57,6 +57,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterBuilderList{},
 		&SourceResolver{},
 		&SourceResolverList{},
+		&CustomBuilder{},
+		&CustomBuilderList{},
+		&Stack{},
+		&StackList{},
+		&Store{},
+		&StoreList{},
+		&ImagePromotion{},
+		&ImagePromotionList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
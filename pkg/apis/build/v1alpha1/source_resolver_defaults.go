@@ -0,0 +1,7 @@
+package v1alpha1
+
+import "context"
+
+func (sr *SourceResolver) SetDefaults(ctx context.Context) {
+	// nothing to do
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 )
 
@@ -16,6 +17,11 @@ type SourceResolver struct {
 	Status            SourceResolverStatus `json:"status"`
 }
 
+var (
+	_ apis.Validatable = (*SourceResolver)(nil)
+	_ apis.Defaultable = (*SourceResolver)(nil)
+)
+
 type SourceResolverSpec struct {
 	ServiceAccount string       `json:"serviceAccount"`
 	Source         SourceConfig `json:"source"`
@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (sr *SourceResolver) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return sr.Spec.Source.Validate(ctx).ViaField("spec", "source")
+}
+
+func (s *SourceConfig) Validate(ctx context.Context) *apis.FieldError {
+	var sources []string
+	if s.Git != nil {
+		sources = append(sources, "git")
+	}
+	if s.Blob != nil {
+		sources = append(sources, "blob")
+	}
+	if s.Registry != nil {
+		sources = append(sources, "registry")
+	}
+
+	if len(sources) == 0 {
+		return apis.ErrMissingOneOf("git", "blob", "registry")
+	}
+
+	if len(sources) > 1 {
+		return apis.ErrMultipleOneOf(sources...)
+	}
+
+	switch {
+	case s.Git != nil:
+		return s.Git.Validate(ctx).ViaField("git")
+	case s.Blob != nil:
+		return s.Blob.Validate(ctx).ViaField("blob")
+	default:
+		return s.Registry.Validate(ctx).ViaField("registry")
+	}
+}
+
+func (g *Git) Validate(ctx context.Context) *apis.FieldError {
+	if g.URL == "" {
+		return apis.ErrMissingField("url")
+	}
+
+	if g.Revision == "" {
+		return apis.ErrMissingField("revision")
+	}
+	return nil
+}
+
+func (b *Blob) Validate(ctx context.Context) *apis.FieldError {
+	if b.URL == "" {
+		return apis.ErrMissingField("url")
+	}
+	return nil
+}
+
+func (r *Registry) Validate(ctx context.Context) *apis.FieldError {
+	return validateImage(r.Image)
+}
@@ -83,23 +83,7 @@ type Registry struct {
 }
 
 func (r *Registry) ImagePullSecretsVolume() corev1.Volume {
-	if len(r.ImagePullSecrets) > 0 {
-		return corev1.Volume{
-			Name: imagePullSecretsDirName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: r.ImagePullSecrets[0].Name,
-				},
-			},
-		}
-	} else {
-		return corev1.Volume{
-			Name: imagePullSecretsDirName,
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
-		}
-	}
+	return pullSecretsVolume(imagePullSecretsDirName, r.ImagePullSecrets)
 }
 
 func (r *Registry) BuildEnvVars() []corev1.EnvVar {
@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const StackKind = "Stack"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec"`
+	Status StackStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Stack)(nil)
+	_ apis.Defaultable = (*Stack)(nil)
+)
+
+type StackSpec struct {
+	ID         string         `json:"id"`
+	BuildImage StackSpecImage `json:"buildImage"`
+	RunImage   StackSpecImage `json:"runImage"`
+	Mixins     []string       `json:"mixins,omitempty"`
+	// ServiceAccountRef is the namespaced service account whose secrets are
+	// used to pull the build and run images.
+	ServiceAccountRef *v1.ObjectReference `json:"serviceAccountRef,omitempty"`
+}
+
+type StackSpecImage struct {
+	Image string `json:"image"`
+}
+
+type StackStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	ResolvedStack       `json:",inline"`
+}
+
+type ResolvedStack struct {
+	ID         string           `json:"id,omitempty"`
+	BuildImage StackStatusImage `json:"buildImage,omitempty"`
+	RunImage   StackStatusImage `json:"runImage,omitempty"`
+	Mixins     []string         `json:"mixins,omitempty"`
+}
+
+type StackStatusImage struct {
+	LatestImage string `json:"latestImage,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Stack `json:"items"`
+}
+
+func (*Stack) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StackKind)
+}
+
+func (s *Stack) Ref() v1.ObjectReference {
+	gvk := s.GetGroupVersionKind()
+	return v1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       s.Name,
+	}
+}
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func (s *Stack) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *StackSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if ss.ID == "" {
+		errs = errs.Also(apis.ErrMissingField("id"))
+	}
+
+	return errs.
+		Also(validateImage(ss.BuildImage.Image).ViaField("buildImage")).
+		Also(validateImage(ss.RunImage.Image).ViaField("runImage")).
+		Also(validateServiceAccountRef(ss.ServiceAccountRef).ViaField("serviceAccountRef"))
+}
+
+// validateServiceAccountRef requires the namespace of the service account
+// because Stacks and Stores are cluster scoped.
+func validateServiceAccountRef(ref *corev1.ObjectReference) *apis.FieldError {
+	if ref == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	if ref.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if ref.Namespace == "" {
+		errs = errs.Also(apis.ErrMissingField("namespace"))
+	}
+	return errs
+}
@@ -0,0 +1,65 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestStackValidation(t *testing.T) {
+	spec.Run(t, "Stack Validation", testStackValidation)
+}
+
+func testStackValidation(t *testing.T, when spec.G, it spec.S) {
+	stack := &v1alpha1.Stack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "stack-name",
+		},
+		Spec: v1alpha1.StackSpec{
+			ID:         "io.buildpacks.stacks.bionic",
+			BuildImage: v1alpha1.StackSpecImage{Image: "cloudfoundry/build:base-cnb"},
+			RunImage:   v1alpha1.StackSpecImage{Image: "cloudfoundry/run:base-cnb"},
+			Mixins:     []string{"some-mixin", "build:some-build-mixin"},
+		},
+	}
+
+	it("returns nil on no validation error", func() {
+		assert.Nil(t, stack.Validate(context.TODO()))
+	})
+
+	it("missing id", func() {
+		stack.Spec.ID = ""
+		assert.EqualError(t, stack.Validate(context.TODO()), apis.ErrMissingField("id").ViaField("spec").Error())
+	})
+
+	it("missing images", func() {
+		stack.Spec.BuildImage.Image = ""
+		stack.Spec.RunImage.Image = ""
+		assert.EqualError(t, stack.Validate(context.TODO()),
+			apis.ErrMissingField("buildImage.image", "runImage.image").ViaField("spec").Error())
+	})
+
+	it("invalid run image", func() {
+		stack.Spec.RunImage.Image = "invalid@@image"
+		assert.EqualError(t, stack.Validate(context.TODO()),
+			apis.ErrInvalidValue("invalid@@image", "image").ViaField("runImage").ViaField("spec").Error())
+	})
+
+	it("service account ref without a namespace", func() {
+		stack.Spec.ServiceAccountRef = &corev1.ObjectReference{Name: "some-sa"}
+		assert.EqualError(t, stack.Validate(context.TODO()),
+			apis.ErrMissingField("namespace").ViaField("serviceAccountRef").ViaField("spec").Error())
+	})
+
+	it("skips validation on status update", func() {
+		stack.Spec = v1alpha1.StackSpec{}
+		assert.Nil(t, stack.Validate(apis.WithinSubResourceUpdate(context.TODO(), stack, "status")))
+	})
+}
@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const StoreKind = "Store"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type Store struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StoreSpec   `json:"spec"`
+	Status StoreStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Store)(nil)
+	_ apis.Defaultable = (*Store)(nil)
+)
+
+type StoreSpec struct {
+	Sources []StoreImage `json:"sources"`
+	// ServiceAccountRef is the namespaced service account whose secrets are
+	// used to pull the source images.
+	ServiceAccountRef *v1.ObjectReference `json:"serviceAccountRef,omitempty"`
+}
+
+type StoreStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	Buildpacks          []StoreBuildpack `json:"buildpacks,omitempty"`
+}
+
+type StoreBuildpack struct {
+	ID         string           `json:"id"`
+	Version    string           `json:"version"`
+	StoreImage StoreImage       `json:"storeImage"`
+	DiffID     string           `json:"diffId"`
+	API        string           `json:"api,omitempty"`
+	Stacks     []BuildpackStack `json:"stacks,omitempty"`
+}
+
+type BuildpackStack struct {
+	ID string `json:"id"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type StoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Store `json:"items"`
+}
+
+func (*Store) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StoreKind)
+}
+
+func (s *Store) Ref() v1.ObjectReference {
+	gvk := s.GetGroupVersionKind()
+	return v1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       s.Name,
+	}
+}
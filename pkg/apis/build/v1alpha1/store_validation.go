@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+func (s *Store) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ss *StoreSpec) Validate(ctx context.Context) *apis.FieldError {
+	if len(ss.Sources) == 0 {
+		return apis.ErrMissingField("sources")
+	}
+
+	errs := validateServiceAccountRef(ss.ServiceAccountRef).ViaField("serviceAccountRef")
+	for i, source := range ss.Sources {
+		errs = errs.Also(validateImage(source.Image).ViaFieldIndex("sources", i))
+	}
+	return errs
+}
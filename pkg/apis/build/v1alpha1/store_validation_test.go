@@ -0,0 +1,52 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestStoreValidation(t *testing.T) {
+	spec.Run(t, "Store Validation", testStoreValidation)
+}
+
+func testStoreValidation(t *testing.T, when spec.G, it spec.S) {
+	store := &v1alpha1.Store{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "store-name",
+		},
+		Spec: v1alpha1.StoreSpec{
+			Sources: []v1alpha1.StoreImage{
+				{Image: "gcr.io/org/buildpackage"},
+			},
+		},
+	}
+
+	it("returns nil on no validation error", func() {
+		assert.Nil(t, store.Validate(context.TODO()))
+	})
+
+	it("missing sources", func() {
+		store.Spec.Sources = nil
+		assert.EqualError(t, store.Validate(context.TODO()), apis.ErrMissingField("sources").ViaField("spec").Error())
+	})
+
+	it("invalid source image", func() {
+		store.Spec.Sources[0].Image = "invalid@@image"
+		assert.EqualError(t, store.Validate(context.TODO()),
+			apis.ErrInvalidValue("invalid@@image", "image").ViaFieldIndex("sources", 0).ViaField("spec").Error())
+	})
+
+	it("service account ref without a name", func() {
+		store.Spec.ServiceAccountRef = &corev1.ObjectReference{Namespace: "some-namespace"}
+		assert.EqualError(t, store.Validate(context.TODO()),
+			apis.ErrMissingField("name").ViaField("serviceAccountRef").ViaField("spec").Error())
+	})
+}
@@ -27,6 +27,44 @@ import (
 	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BOMBuildpack) DeepCopyInto(out *BOMBuildpack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BOMBuildpack.
+func (in *BOMBuildpack) DeepCopy() *BOMBuildpack {
+	if in == nil {
+		return nil
+	}
+	out := new(BOMBuildpack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BOMEntry) DeepCopyInto(out *BOMEntry) {
+	*out = *in
+	out.Buildpack = in.Buildpack
+	if in.Licenses != nil {
+		in, out := &in.Licenses, &out.Licenses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BOMEntry.
+func (in *BOMEntry) DeepCopy() *BOMEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(BOMEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Blob) DeepCopyInto(out *Blob) {
 	*out = *in
@@ -125,6 +163,50 @@ func (in *BuildList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildPlatformStatus) DeepCopyInto(out *BuildPlatformStatus) {
+	*out = *in
+	if in.StepsCompleted != nil {
+		in, out := &in.StepsCompleted, &out.StepsCompleted
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(duckv1alpha1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlatformStatus.
+func (in *BuildPlatformStatus) DeepCopy() *BuildPlatformStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildPlatformStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildPodBuilderConfig) DeepCopyInto(out *BuildPodBuilderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPodBuilderConfig.
+func (in *BuildPodBuilderConfig) DeepCopy() *BuildPodBuilderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildPodBuilderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildPodConfig) DeepCopyInto(out *BuildPodConfig) {
 	*out = *in
@@ -141,6 +223,22 @@ func (in *BuildPodConfig) DeepCopy() *BuildPodConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildSignature) DeepCopyInto(out *BuildSignature) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildSignature.
+func (in *BuildSignature) DeepCopy() *BuildSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
@@ -160,6 +258,18 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
 	out.LastBuild = in.LastBuild
+	if in.ImageLabels != nil {
+		in, out := &in.ImageLabels, &out.ImageLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -194,6 +304,50 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PushedTags != nil {
+		in, out := &in.PushedTags, &out.PushedTags
+		*out = make([]PushedTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BOM != nil {
+		in, out := &in.BOM, &out.BOM
+		*out = make([]BOMEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(BuildSignature)
+		**out = **in
+	}
+	if in.ImageLabels != nil {
+		in, out := &in.ImageLabels, &out.ImageLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]BuildPlatformStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -301,6 +455,18 @@ func (in *BuilderStatus) DeepCopyInto(out *BuilderStatus) {
 		*out = make(BuildpackMetadataList, len(*in))
 		copy(*out, *in)
 	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]OrderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ObservedRevisions != nil {
+		in, out := &in.ObservedRevisions, &out.ObservedRevisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -372,6 +538,38 @@ func (in BuildpackMetadataList) DeepCopy() BuildpackMetadataList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackRef) DeepCopyInto(out *BuildpackRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackRef.
+func (in *BuildpackRef) DeepCopy() *BuildpackRef {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildpackStack) DeepCopyInto(out *BuildpackStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildpackStack.
+func (in *BuildpackStack) DeepCopy() *BuildpackStack {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildpackStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterBuilder) DeepCopyInto(out *ClusterBuilder) {
 	*out = *in
@@ -441,6 +639,125 @@ func (in *ClusterBuilderList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomBuilder) DeepCopyInto(out *CustomBuilder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomBuilder.
+func (in *CustomBuilder) DeepCopy() *CustomBuilder {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomBuilder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *CustomBuilder) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomBuilder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomBuilderList) DeepCopyInto(out *CustomBuilderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomBuilder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomBuilderList.
+func (in *CustomBuilderList) DeepCopy() *CustomBuilderList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomBuilderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomBuilderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomBuilderSpec) DeepCopyInto(out *CustomBuilderSpec) {
+	*out = *in
+	out.Stack = in.Stack
+	if in.Store != nil {
+		in, out := &in.Store, &out.Store
+		*out = make([]StoreImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]OrderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomBuilderSpec.
+func (in *CustomBuilderSpec) DeepCopy() *CustomBuilderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomBuilderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomStack) DeepCopyInto(out *CustomStack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomStack.
+func (in *CustomStack) DeepCopy() *CustomStack {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Git) DeepCopyInto(out *Git) {
 	*out = *in
@@ -560,45 +877,258 @@ func (in *ImageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+func (in *ImagePromotion) DeepCopyInto(out *ImagePromotion) {
 	*out = *in
-	out.Builder = in.Builder
-	in.Source.DeepCopyInto(&out.Source)
-	if in.CacheSize != nil {
-		in, out := &in.CacheSize, &out.CacheSize
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.FailedBuildHistoryLimit != nil {
-		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
-		*out = new(int64)
-		**out = **in
-	}
-	if in.SuccessBuildHistoryLimit != nil {
-		in, out := &in.SuccessBuildHistoryLimit, &out.SuccessBuildHistoryLimit
-		*out = new(int64)
-		**out = **in
-	}
-	in.Build.DeepCopyInto(&out.Build)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
-func (in *ImageSpec) DeepCopy() *ImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePromotion.
+func (in *ImagePromotion) DeepCopy() *ImagePromotion {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSpec)
+	out := new(ImagePromotion)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
-	*out = *in
-	in.Status.DeepCopyInto(&out.Status)
-	return
-}
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *ImagePromotion) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePromotion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePromotionList) DeepCopyInto(out *ImagePromotionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImagePromotion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePromotionList.
+func (in *ImagePromotionList) DeepCopy() *ImagePromotionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePromotionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePromotionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePromotionSpec) DeepCopyInto(out *ImagePromotionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePromotionSpec.
+func (in *ImagePromotionSpec) DeepCopy() *ImagePromotionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePromotionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePromotionStatus) DeepCopyInto(out *ImagePromotionStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePromotionStatus.
+func (in *ImagePromotionStatus) DeepCopy() *ImagePromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryCache) DeepCopyInto(out *ImageRegistryCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryCache.
+func (in *ImageRegistryCache) DeepCopy() *ImageRegistryCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRetention) DeepCopyInto(out *ImageRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRetention.
+func (in *ImageRetention) DeepCopy() *ImageRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRunImage) DeepCopyInto(out *ImageRunImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRunImage.
+func (in *ImageRunImage) DeepCopy() *ImageRunImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRunImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSigning) DeepCopyInto(out *ImageSigning) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSigning.
+func (in *ImageSigning) DeepCopy() *ImageSigning {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSigning)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	out.Builder = in.Builder
+	in.Source.DeepCopyInto(&out.Source)
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.FailedBuildHistoryLimit != nil {
+		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessBuildHistoryLimit != nil {
+		in, out := &in.SuccessBuildHistoryLimit, &out.SuccessBuildHistoryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TagTemplates != nil {
+		in, out := &in.TagTemplates, &out.TagTemplates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalTags != nil {
+		in, out := &in.AdditionalTags, &out.AdditionalTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageLabels != nil {
+		in, out := &in.ImageLabels, &out.ImageLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Platforms != nil {
+		in, out := &in.Platforms, &out.Platforms
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Build.DeepCopyInto(&out.Build)
+	if in.RunImage != nil {
+		in, out := &in.RunImage, &out.RunImage
+		*out = new(ImageRunImage)
+		**out = **in
+	}
+	if in.RegistryCache != nil {
+		in, out := &in.RegistryCache, &out.RegistryCache
+		*out = new(ImageRegistryCache)
+		**out = **in
+	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(ImageSigning)
+		**out = **in
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ImageRetention)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.BuildCacheSize != nil {
+		in, out := &in.BuildCacheSize, &out.BuildCacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
 func (in *ImageStatus) DeepCopy() *ImageStatus {
@@ -626,6 +1156,43 @@ func (in *LastBuild) DeepCopy() *LastBuild {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrderEntry) DeepCopyInto(out *OrderEntry) {
+	*out = *in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = make([]BuildpackRef, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrderEntry.
+func (in *OrderEntry) DeepCopy() *OrderEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(OrderEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PushedTag) DeepCopyInto(out *PushedTag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PushedTag.
+func (in *PushedTag) DeepCopy() *PushedTag {
+	if in == nil {
+		return nil
+	}
+	out := new(PushedTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReconciledBuild) DeepCopyInto(out *ReconciledBuild) {
 	*out = *in
@@ -634,6 +1201,11 @@ func (in *ReconciledBuild) DeepCopyInto(out *ReconciledBuild) {
 		*out = new(Build)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(duckv1alpha1.Conditions, len(*in))
@@ -759,6 +1331,29 @@ func (in *ResolvedSourceConfig) DeepCopy() *ResolvedSourceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedStack) DeepCopyInto(out *ResolvedStack) {
+	*out = *in
+	out.BuildImage = in.BuildImage
+	out.RunImage = in.RunImage
+	if in.Mixins != nil {
+		in, out := &in.Mixins, &out.Mixins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedStack.
+func (in *ResolvedStack) DeepCopy() *ResolvedStack {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceConfig) DeepCopyInto(out *SourceConfig) {
 	*out = *in
@@ -885,3 +1480,339 @@ func (in *SourceResolverStatus) DeepCopy() *SourceResolverStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Stack) DeepCopyInto(out *Stack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Stack.
+func (in *Stack) DeepCopy() *Stack {
+	if in == nil {
+		return nil
+	}
+	out := new(Stack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Stack) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Stack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackList) DeepCopyInto(out *StackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Stack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackList.
+func (in *StackList) DeepCopy() *StackList {
+	if in == nil {
+		return nil
+	}
+	out := new(StackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackSpec) DeepCopyInto(out *StackSpec) {
+	*out = *in
+	out.BuildImage = in.BuildImage
+	out.RunImage = in.RunImage
+	if in.Mixins != nil {
+		in, out := &in.Mixins, &out.Mixins
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSpec.
+func (in *StackSpec) DeepCopy() *StackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackSpecImage) DeepCopyInto(out *StackSpecImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackSpecImage.
+func (in *StackSpecImage) DeepCopy() *StackSpecImage {
+	if in == nil {
+		return nil
+	}
+	out := new(StackSpecImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackStatus) DeepCopyInto(out *StackStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.ResolvedStack.DeepCopyInto(&out.ResolvedStack)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackStatus.
+func (in *StackStatus) DeepCopy() *StackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StackStatusImage) DeepCopyInto(out *StackStatusImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StackStatusImage.
+func (in *StackStatusImage) DeepCopy() *StackStatusImage {
+	if in == nil {
+		return nil
+	}
+	out := new(StackStatusImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Store) DeepCopyInto(out *Store) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Store.
+func (in *Store) DeepCopy() *Store {
+	if in == nil {
+		return nil
+	}
+	out := new(Store)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *Store) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Store) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreBuildpack) DeepCopyInto(out *StoreBuildpack) {
+	*out = *in
+	out.StoreImage = in.StoreImage
+	if in.Stacks != nil {
+		in, out := &in.Stacks, &out.Stacks
+		*out = make([]BuildpackStack, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreBuildpack.
+func (in *StoreBuildpack) DeepCopy() *StoreBuildpack {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreBuildpack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreImage) DeepCopyInto(out *StoreImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreImage.
+func (in *StoreImage) DeepCopy() *StoreImage {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreList) DeepCopyInto(out *StoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Store, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreList.
+func (in *StoreList) DeepCopy() *StoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreSpec) DeepCopyInto(out *StoreSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]StoreImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreSpec.
+func (in *StoreSpec) DeepCopy() *StoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreStatus) DeepCopyInto(out *StoreStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Buildpacks != nil {
+		in, out := &in.Buildpacks, &out.Buildpacks
+		*out = make([]StoreBuildpack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreStatus.
+func (in *StoreStatus) DeepCopy() *StoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagTemplateData) DeepCopyInto(out *TagTemplateData) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagTemplateData.
+func (in *TagTemplateData) DeepCopy() *TagTemplateData {
+	if in == nil {
+		return nil
+	}
+	out := new(TagTemplateData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityRebuildPolicy) DeepCopyInto(out *VulnerabilityRebuildPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityRebuildPolicy.
+func (in *VulnerabilityRebuildPolicy) DeepCopy() *VulnerabilityRebuildPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityRebuildPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,196 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 Build sink.
+func (b *Build) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.Build:
+		source := b.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 Build.
+func (b *Build) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.Build:
+		source = source.DeepCopy()
+		b.ObjectMeta = source.ObjectMeta
+		b.Spec.convertFrom(&source.Spec)
+		b.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (bs *BuildSpec) convertTo(sink *v1alpha1.BuildSpec) {
+	sink.Tags = bs.Tags
+	sink.Builder = v1alpha1.BuildBuilderSpec{
+		Image:            bs.Builder.Image,
+		ImagePullSecrets: bs.Builder.ImagePullSecrets,
+	}
+	sink.ServiceAccount = bs.ServiceAccount
+	bs.Source.convertTo(&sink.Source)
+	sink.CacheName = bs.CacheName
+	sink.Env = bs.Env
+	sink.Resources = bs.Resources
+	sink.LastBuild = v1alpha1.LastBuild{
+		Image: bs.LastBuild.Image,
+	}
+	sink.RunImage = bs.RunImage
+	sink.CacheImage = bs.CacheImage
+	sink.ClearCache = bs.ClearCache
+	sink.SigningSecret = bs.SigningSecret
+	sink.ImageLabels = bs.ImageLabels
+	sink.Platforms = bs.Platforms
+}
+
+func (bs *BuildSpec) convertFrom(source *v1alpha1.BuildSpec) {
+	bs.Tags = source.Tags
+	bs.Builder = BuildBuilderSpec{
+		Image:            source.Builder.Image,
+		ImagePullSecrets: source.Builder.ImagePullSecrets,
+	}
+	bs.ServiceAccount = source.ServiceAccount
+	bs.Source.convertFrom(&source.Source)
+	bs.CacheName = source.CacheName
+	bs.Env = source.Env
+	bs.Resources = source.Resources
+	bs.LastBuild = LastBuild{
+		Image: source.LastBuild.Image,
+	}
+	bs.RunImage = source.RunImage
+	bs.CacheImage = source.CacheImage
+	bs.ClearCache = source.ClearCache
+	bs.SigningSecret = source.SigningSecret
+	bs.ImageLabels = source.ImageLabels
+	bs.Platforms = source.Platforms
+}
+
+func (bs *BuildStatus) convertTo(sink *v1alpha1.BuildStatus) {
+	sink.Status = bs.Status
+	sink.BuildMetadata = bs.BuildMetadata.convertTo()
+	sink.RunImage = bs.RunImage
+	sink.LatestImage = bs.LatestImage
+	sink.PodName = bs.PodName
+	sink.StepStates = bs.StepStates
+	sink.StepsCompleted = bs.StepsCompleted
+	sink.StartTime = bs.StartTime
+	sink.CompletionTime = bs.CompletionTime
+	sink.QueuePosition = bs.QueuePosition
+	sink.PushedTags = convertPushedTagsTo(bs.PushedTags)
+	sink.CacheSize = bs.CacheSize
+	sink.BOM = convertBOMTo(bs.BOM)
+	sink.BOMConfigMap = bs.BOMConfigMap
+	sink.ImageLabels = bs.ImageLabels
+	sink.ExportedImage = bs.ExportedImage
+	sink.Platforms = convertPlatformStatusesTo(bs.Platforms)
+	if bs.Signature != nil {
+		sink.Signature = &v1alpha1.BuildSignature{
+			Signature:   bs.Signature.Signature,
+			Attestation: bs.Signature.Attestation,
+		}
+	}
+}
+
+func (bs *BuildStatus) convertFrom(source *v1alpha1.BuildStatus) {
+	bs.Status = source.Status
+	bs.BuildMetadata = convertBuildpackMetadataFrom(source.BuildMetadata)
+	bs.RunImage = source.RunImage
+	bs.LatestImage = source.LatestImage
+	bs.PodName = source.PodName
+	bs.StepStates = source.StepStates
+	bs.StepsCompleted = source.StepsCompleted
+	bs.StartTime = source.StartTime
+	bs.CompletionTime = source.CompletionTime
+	bs.QueuePosition = source.QueuePosition
+	bs.PushedTags = convertPushedTagsFrom(source.PushedTags)
+	bs.CacheSize = source.CacheSize
+	bs.BOM = convertBOMFrom(source.BOM)
+	bs.BOMConfigMap = source.BOMConfigMap
+	bs.ImageLabels = source.ImageLabels
+	bs.ExportedImage = source.ExportedImage
+	bs.Platforms = convertPlatformStatusesFrom(source.Platforms)
+	if source.Signature != nil {
+		bs.Signature = &BuildSignature{
+			Signature:   source.Signature.Signature,
+			Attestation: source.Signature.Attestation,
+		}
+	}
+}
+
+func convertPushedTagsTo(tags []PushedTag) []v1alpha1.PushedTag {
+	var sink []v1alpha1.PushedTag
+	for _, t := range tags {
+		sink = append(sink, v1alpha1.PushedTag(t))
+	}
+	return sink
+}
+
+func convertPushedTagsFrom(tags []v1alpha1.PushedTag) []PushedTag {
+	var sink []PushedTag
+	for _, t := range tags {
+		sink = append(sink, PushedTag(t))
+	}
+	return sink
+}
+
+func convertPlatformStatusesTo(platforms []BuildPlatformStatus) []v1alpha1.BuildPlatformStatus {
+	var sink []v1alpha1.BuildPlatformStatus
+	for _, p := range platforms {
+		sink = append(sink, v1alpha1.BuildPlatformStatus(p))
+	}
+	return sink
+}
+
+func convertPlatformStatusesFrom(platforms []v1alpha1.BuildPlatformStatus) []BuildPlatformStatus {
+	var sink []BuildPlatformStatus
+	for _, p := range platforms {
+		sink = append(sink, BuildPlatformStatus(p))
+	}
+	return sink
+}
+
+func convertBOMTo(bom []BOMEntry) []v1alpha1.BOMEntry {
+	var sink []v1alpha1.BOMEntry
+	for _, e := range bom {
+		sink = append(sink, v1alpha1.BOMEntry{
+			Name:      e.Name,
+			Version:   e.Version,
+			Buildpack: v1alpha1.BOMBuildpack(e.Buildpack),
+			SHA256:    e.SHA256,
+			URI:       e.URI,
+			Licenses:  e.Licenses,
+		})
+	}
+	return sink
+}
+
+func convertBOMFrom(bom []v1alpha1.BOMEntry) []BOMEntry {
+	var sink []BOMEntry
+	for _, e := range bom {
+		sink = append(sink, BOMEntry{
+			Name:      e.Name,
+			Version:   e.Version,
+			Buildpack: BOMBuildpack(e.Buildpack),
+			SHA256:    e.SHA256,
+			URI:       e.URI,
+			Licenses:  e.Licenses,
+		})
+	}
+	return sink
+}
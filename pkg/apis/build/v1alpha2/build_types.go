@@ -0,0 +1,143 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Build struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuildSpec   `json:"spec"`
+	Status BuildStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Build)(nil)
+	_ apis.Defaultable = (*Build)(nil)
+)
+
+type BuildBuilderSpec struct {
+	Image            string                        `json:"image"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+}
+
+type BuildSpec struct {
+	Tags           []string                    `json:"tags"`
+	Builder        BuildBuilderSpec            `json:"builder"`
+	ServiceAccount string                      `json:"serviceAccount"`
+	Source         SourceConfig                `json:"source"`
+	CacheName      string                      `json:"cacheName"`
+	Env            []corev1.EnvVar             `json:"env"`
+	Resources      corev1.ResourceRequirements `json:"resources"`
+	LastBuild      LastBuild                   `json:"lastBuild"`
+	RunImage       string                      `json:"runImage,omitempty"`
+	CacheImage     string                      `json:"cacheImage,omitempty"`
+	ClearCache     bool                        `json:"clearCache,omitempty"`
+	SigningSecret  string                      `json:"signingSecret,omitempty"`
+	ImageLabels    map[string]string           `json:"imageLabels,omitempty"`
+	Platforms      []string                    `json:"platforms,omitempty"`
+}
+
+type LastBuild struct {
+	Image string `json:"image"`
+}
+
+type BuildStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	BuildMetadata       BuildpackMetadataList   `json:"buildMetadata"`
+	RunImage            string                  `json:"runImage"`
+	LatestImage         string                  `json:"latestImage"`
+	PodName             string                  `json:"podName"`
+	StepStates          []corev1.ContainerState `json:"stepStates,omitempty"`
+	StepsCompleted      []string                `json:"stepsCompleted,omitempty"`
+	StartTime           *metav1.Time            `json:"startTime,omitempty"`
+	CompletionTime      *metav1.Time            `json:"completionTime,omitempty"`
+	QueuePosition       int                     `json:"queuePosition,omitempty"`
+	PushedTags          []PushedTag             `json:"pushedTags,omitempty"`
+	CacheSize           *resource.Quantity      `json:"cacheSize,omitempty"`
+	BOM                 []BOMEntry              `json:"bom,omitempty"`
+	BOMConfigMap        string                  `json:"bomConfigMap,omitempty"`
+	Signature           *BuildSignature         `json:"signature,omitempty"`
+	ImageLabels         map[string]string       `json:"imageLabels,omitempty"`
+	ExportedImage       string                  `json:"exportedImage,omitempty"`
+	Platforms           []BuildPlatformStatus   `json:"platforms,omitempty"`
+}
+
+// BuildPlatformStatus is the status of the pod building the image for one of
+// the platforms of a multi-platform build.
+type BuildPlatformStatus struct {
+	Platform       string                  `json:"platform"`
+	PodName        string                  `json:"podName"`
+	LatestImage    string                  `json:"latestImage,omitempty"`
+	ExportedImage  string                  `json:"exportedImage,omitempty"`
+	StepsCompleted []string                `json:"stepsCompleted,omitempty"`
+	Conditions     duckv1alpha1.Conditions `json:"conditions,omitempty"`
+}
+
+// PushedTag is a tag written by the build and the digest it was verified to
+// resolve to once the build completed. The digest is empty when the tag was
+// overwritten by another image before it could be verified.
+type PushedTag struct {
+	Tag    string `json:"tag"`
+	Digest string `json:"digest"`
+}
+
+// BOMEntry is a dependency a buildpack contributed to the built image as read
+// from the bill of materials the lifecycle writes to the image.
+type BOMEntry struct {
+	Name      string       `json:"name"`
+	Version   string       `json:"version,omitempty"`
+	Buildpack BOMBuildpack `json:"buildpack"`
+	SHA256    string       `json:"sha256,omitempty"`
+	URI       string       `json:"uri,omitempty"`
+	Licenses  []string     `json:"licenses,omitempty"`
+}
+
+// BuildSignature references the signature and provenance attestation pushed
+// next to the built image.
+type BuildSignature struct {
+	Signature   string `json:"signature"`
+	Attestation string `json:"attestation"`
+}
+
+type BOMBuildpack struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BuildList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Build `json:"items"`
+}
+
+func (*Build) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Build")
+}
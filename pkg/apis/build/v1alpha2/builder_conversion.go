@@ -0,0 +1,104 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 Builder sink.
+func (b *Builder) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.Builder:
+		source := b.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.BuilderSpec.convertTo(&sink.Spec.BuilderSpec)
+		sink.Spec.ImagePullSecrets = source.Spec.ImagePullSecrets
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 Builder.
+func (b *Builder) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.Builder:
+		source = source.DeepCopy()
+		b.ObjectMeta = source.ObjectMeta
+		b.Spec.BuilderSpec.convertFrom(&source.Spec.BuilderSpec)
+		b.Spec.ImagePullSecrets = source.Spec.ImagePullSecrets
+		b.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// ConvertTo converts the receiver into the v1alpha1 ClusterBuilder sink.
+func (c *ClusterBuilder) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.ClusterBuilder:
+		source := c.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 ClusterBuilder.
+func (c *ClusterBuilder) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.ClusterBuilder:
+		source = source.DeepCopy()
+		c.ObjectMeta = source.ObjectMeta
+		c.Spec.convertFrom(&source.Spec)
+		c.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (bs *BuilderSpec) convertTo(sink *v1alpha1.BuilderSpec) {
+	sink.Image = bs.Image
+	sink.UpdatePolicy = v1alpha1.BuilderUpdatePolicy(bs.UpdatePolicy)
+}
+
+func (bs *BuilderSpec) convertFrom(source *v1alpha1.BuilderSpec) {
+	bs.Image = source.Image
+	bs.UpdatePolicy = BuilderUpdatePolicy(source.UpdatePolicy)
+}
+
+func (bs *BuilderStatus) convertTo(sink *v1alpha1.BuilderStatus) {
+	sink.Status = bs.Status
+	sink.BuilderMetadata = bs.BuilderMetadata.convertTo()
+	sink.Order = convertOrderTo(bs.Order)
+	sink.StackID = bs.StackID
+	sink.LifecycleVersion = bs.LifecycleVersion
+	sink.RunImage = bs.RunImage
+	sink.LatestImage = bs.LatestImage
+	sink.PreviousImage = bs.PreviousImage
+	sink.PendingUpdate = bs.PendingUpdate
+	sink.ObservedRevisions = bs.ObservedRevisions
+}
+
+func (bs *BuilderStatus) convertFrom(source *v1alpha1.BuilderStatus) {
+	bs.Status = source.Status
+	bs.BuilderMetadata = convertBuildpackMetadataFrom(source.BuilderMetadata)
+	bs.Order = convertOrderFrom(source.Order)
+	bs.StackID = source.StackID
+	bs.LifecycleVersion = source.LifecycleVersion
+	bs.RunImage = source.RunImage
+	bs.LatestImage = source.LatestImage
+	bs.PreviousImage = source.PreviousImage
+	bs.PendingUpdate = source.PendingUpdate
+	bs.ObservedRevisions = source.ObservedRevisions
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const BuilderKind = "Builder"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type Builder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuilderWithSecretsSpec `json:"spec"`
+	Status BuilderStatus          `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Builder)(nil)
+	_ apis.Defaultable = (*Builder)(nil)
+)
+
+type BuilderSpec struct {
+	Image        string              `json:"image"`
+	UpdatePolicy BuilderUpdatePolicy `json:"updatePolicy"`
+}
+
+type BuilderWithSecretsSpec struct {
+	BuilderSpec      `json:",inline"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+}
+
+type BuilderUpdatePolicy string
+
+const (
+	Polling  BuilderUpdatePolicy = "polling"
+	External BuilderUpdatePolicy = "external"
+	Approval BuilderUpdatePolicy = "approval"
+)
+
+type BuilderStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	BuilderMetadata     BuildpackMetadataList `json:"builderMetadata"`
+	Order               []OrderEntry          `json:"order,omitempty"`
+	StackID             string                `json:"stackId,omitempty"`
+	LifecycleVersion    string                `json:"lifecycleVersion,omitempty"`
+	RunImage            string                `json:"runImage"`
+	LatestImage         string                `json:"latestImage"`
+	PreviousImage       string                `json:"previousImage,omitempty"`
+	PendingUpdate       string                `json:"pendingUpdate,omitempty"`
+	ObservedRevisions   []string              `json:"observedRevisions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type BuilderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Builder `json:"items"`
+}
+
+func (*Builder) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(BuilderKind)
+}
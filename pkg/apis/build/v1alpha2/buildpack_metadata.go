@@ -0,0 +1,9 @@
+package v1alpha2
+
+type BuildpackMetadataList []BuildpackMetadata
+
+type BuildpackMetadata struct {
+	ID       string `json:"id"`
+	Version  string `json:"version"`
+	Homepage string `json:"homepage,omitempty"`
+}
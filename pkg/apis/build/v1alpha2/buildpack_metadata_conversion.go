@@ -0,0 +1,37 @@
+package v1alpha2
+
+import (
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func (l BuildpackMetadataList) convertTo() v1alpha1.BuildpackMetadataList {
+	if l == nil {
+		return nil
+	}
+
+	sink := make(v1alpha1.BuildpackMetadataList, 0, len(l))
+	for _, bp := range l {
+		sink = append(sink, v1alpha1.BuildpackMetadata{
+			ID:       bp.ID,
+			Version:  bp.Version,
+			Homepage: bp.Homepage,
+		})
+	}
+	return sink
+}
+
+func convertBuildpackMetadataFrom(source v1alpha1.BuildpackMetadataList) BuildpackMetadataList {
+	if source == nil {
+		return nil
+	}
+
+	l := make(BuildpackMetadataList, 0, len(source))
+	for _, bp := range source {
+		l = append(l, BuildpackMetadata{
+			ID:       bp.ID,
+			Version:  bp.Version,
+			Homepage: bp.Homepage,
+		})
+	}
+	return l
+}
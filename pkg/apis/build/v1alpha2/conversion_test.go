@@ -0,0 +1,111 @@
+package v1alpha2_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func TestConversion(t *testing.T) {
+	spec.Run(t, "Conversion", testConversion)
+}
+
+type convertible interface {
+	runtime.Object
+	ConvertTo(ctx context.Context, to runtime.Object) error
+	ConvertFrom(ctx context.Context, from runtime.Object) error
+}
+
+func testConversion(t *testing.T, when spec.G, it spec.S) {
+	fuzzer := fuzz.New().NilChance(0.2).NumElements(1, 3).MaxDepth(10).Funcs(
+		func(q *resource.Quantity, c fuzz.Continue) {
+			*q = *resource.NewQuantity(c.Int63n(1000), resource.DecimalSI)
+		},
+	)
+
+	when("round tripping through v1alpha2", func() {
+		for name, tc := range map[string]struct {
+			hub   runtime.Object
+			spoke convertible
+		}{
+			"Build":          {&v1alpha1.Build{}, &v1alpha2.Build{}},
+			"Image":          {&v1alpha1.Image{}, &v1alpha2.Image{}},
+			"Builder":        {&v1alpha1.Builder{}, &v1alpha2.Builder{}},
+			"ClusterBuilder": {&v1alpha1.ClusterBuilder{}, &v1alpha2.ClusterBuilder{}},
+			"CustomBuilder":  {&v1alpha1.CustomBuilder{}, &v1alpha2.CustomBuilder{}},
+			"SourceResolver": {&v1alpha1.SourceResolver{}, &v1alpha2.SourceResolver{}},
+			"Stack":          {&v1alpha1.Stack{}, &v1alpha2.Stack{}},
+			"Store":          {&v1alpha1.Store{}, &v1alpha2.Store{}},
+			"ImagePromotion": {&v1alpha1.ImagePromotion{}, &v1alpha2.ImagePromotion{}},
+		} {
+			name, tc := name, tc
+			it("preserves every field of a v1alpha1 "+name, func() {
+				for i := 0; i < 50; i++ {
+					original := tc.hub.DeepCopyObject()
+					fuzzer.Fuzz(original)
+					original.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(name))
+
+					spoke := tc.spoke.DeepCopyObject().(convertible)
+					require.NoError(t, spoke.ConvertFrom(context.TODO(), original))
+
+					roundTripped := tc.hub.DeepCopyObject()
+					require.NoError(t, spoke.ConvertTo(context.TODO(), roundTripped))
+					roundTripped.GetObjectKind().SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind(name))
+
+					require.Equal(t, original, roundTripped)
+				}
+			})
+		}
+	})
+
+	it("errors converting to an unknown version", func() {
+		err := (&v1alpha2.Image{}).ConvertTo(context.TODO(), &v1alpha1.Build{})
+		assert.EqualError(t, err, "unknown version, got: *v1alpha1.Build")
+	})
+
+	when("serializing", func() {
+		it("uses id for buildpack metadata", func() {
+			builder := &v1alpha2.Builder{}
+			require.NoError(t, builder.ConvertFrom(context.TODO(), &v1alpha1.Builder{
+				Status: v1alpha1.BuilderStatus{
+					BuilderMetadata: v1alpha1.BuildpackMetadataList{
+						{ID: "io.buildpack", Version: "1.0"},
+					},
+				},
+			}))
+
+			raw, err := json.Marshal(builder.Status.BuilderMetadata)
+			require.NoError(t, err)
+			assert.JSONEq(t, `[{"id": "io.buildpack", "version": "1.0"}]`, string(raw))
+		})
+
+		it("uses revision for resolved git sources", func() {
+			sourceResolver := &v1alpha2.SourceResolver{}
+			require.NoError(t, sourceResolver.ConvertFrom(context.TODO(), &v1alpha1.SourceResolver{
+				Status: v1alpha1.SourceResolverStatus{
+					Source: v1alpha1.ResolvedSourceConfig{
+						Git: &v1alpha1.ResolvedGitSource{
+							URL:      "https://github.com/some/repo",
+							Revision: "abc123",
+							Type:     v1alpha1.Branch,
+						},
+					},
+				},
+			}))
+
+			raw, err := json.Marshal(sourceResolver.Status.Source.Git)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"url": "https://github.com/some/repo", "revision": "abc123", "type": "Branch"}`, string(raw))
+		})
+	})
+}
@@ -0,0 +1,90 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 CustomBuilder sink.
+func (c *CustomBuilder) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.CustomBuilder:
+		source := c.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 CustomBuilder.
+func (c *CustomBuilder) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.CustomBuilder:
+		source = source.DeepCopy()
+		c.ObjectMeta = source.ObjectMeta
+		c.Spec.convertFrom(&source.Spec)
+		c.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (cs *CustomBuilderSpec) convertTo(sink *v1alpha1.CustomBuilderSpec) {
+	sink.Tag = cs.Tag
+	sink.Stack = v1alpha1.CustomStack(cs.Stack)
+	sink.ServiceAccount = cs.ServiceAccount
+	sink.ImagePullSecrets = cs.ImagePullSecrets
+
+	sink.Store = nil
+	for _, s := range cs.Store {
+		sink.Store = append(sink.Store, v1alpha1.StoreImage(s))
+	}
+
+	sink.Order = convertOrderTo(cs.Order)
+}
+
+func (cs *CustomBuilderSpec) convertFrom(source *v1alpha1.CustomBuilderSpec) {
+	cs.Tag = source.Tag
+	cs.Stack = CustomStack(source.Stack)
+	cs.ServiceAccount = source.ServiceAccount
+	cs.ImagePullSecrets = source.ImagePullSecrets
+
+	cs.Store = nil
+	for _, s := range source.Store {
+		cs.Store = append(cs.Store, StoreImage(s))
+	}
+
+	cs.Order = convertOrderFrom(source.Order)
+}
+
+func convertOrderTo(order []OrderEntry) []v1alpha1.OrderEntry {
+	var sink []v1alpha1.OrderEntry
+	for _, entry := range order {
+		sinkEntry := v1alpha1.OrderEntry{}
+		for _, ref := range entry.Group {
+			sinkEntry.Group = append(sinkEntry.Group, v1alpha1.BuildpackRef(ref))
+		}
+		sink = append(sink, sinkEntry)
+	}
+	return sink
+}
+
+func convertOrderFrom(source []v1alpha1.OrderEntry) []OrderEntry {
+	var order []OrderEntry
+	for _, entry := range source {
+		e := OrderEntry{}
+		for _, ref := range entry.Group {
+			e.Group = append(e.Group, BuildpackRef(ref))
+		}
+		order = append(order, e)
+	}
+	return order
+}
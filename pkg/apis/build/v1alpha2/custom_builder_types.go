@@ -0,0 +1,84 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+const CustomBuilderKind = "CustomBuilder"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type CustomBuilder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomBuilderSpec `json:"spec"`
+	Status BuilderStatus     `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*CustomBuilder)(nil)
+	_ apis.Defaultable = (*CustomBuilder)(nil)
+)
+
+type CustomBuilderSpec struct {
+	Tag              string                        `json:"tag"`
+	Stack            CustomStack                   `json:"stack"`
+	Store            []StoreImage                  `json:"store"`
+	Order            []OrderEntry                  `json:"order"`
+	ServiceAccount   string                        `json:"serviceAccount,omitempty"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+type CustomStack struct {
+	ID         string `json:"id"`
+	BuildImage string `json:"buildImage"`
+	RunImage   string `json:"runImage"`
+}
+
+type StoreImage struct {
+	Image string `json:"image"`
+}
+
+type OrderEntry struct {
+	Group []BuildpackRef `json:"group"`
+}
+
+type BuildpackRef struct {
+	ID       string `json:"id"`
+	Version  string `json:"version,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CustomBuilderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CustomBuilder `json:"items"`
+}
+
+func (*CustomBuilder) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(CustomBuilderKind)
+}
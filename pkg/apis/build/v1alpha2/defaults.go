@@ -0,0 +1,43 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func (b *Build) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, b, &v1alpha1.Build{})
+}
+
+func (i *Image) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, i, &v1alpha1.Image{})
+}
+
+func (b *Builder) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, b, &v1alpha1.Builder{})
+}
+
+func (c *ClusterBuilder) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, c, &v1alpha1.ClusterBuilder{})
+}
+
+func (c *CustomBuilder) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, c, &v1alpha1.CustomBuilder{})
+}
+
+func (s *Stack) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, s, &v1alpha1.Stack{})
+}
+
+func (s *Store) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, s, &v1alpha1.Store{})
+}
+
+func (p *ImagePromotion) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, p, &v1alpha1.ImagePromotion{})
+}
+
+func (sr *SourceResolver) SetDefaults(ctx context.Context) {
+	setDefaultsViaHub(ctx, sr, &v1alpha1.SourceResolver{})
+}
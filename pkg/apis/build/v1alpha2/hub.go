@@ -0,0 +1,50 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+// v1alpha1 is the hub version: it is stored by the api server and understood by
+// the controllers. v1alpha2 only differs in its serialized shape so validation
+// and defaulting are delegated to the hub rather than duplicated here.
+
+type convertible interface {
+	ConvertTo(ctx context.Context, to runtime.Object) error
+	ConvertFrom(ctx context.Context, from runtime.Object) error
+}
+
+type hub interface {
+	runtime.Object
+	apis.Validatable
+	apis.Defaultable
+}
+
+func validateViaHub(ctx context.Context, source convertible, sink hub) *apis.FieldError {
+	if apis.IsInStatusUpdate(ctx) {
+		return nil
+	}
+
+	if baseline, ok := apis.GetBaseline(ctx).(convertible); ok && baseline != nil {
+		hubBaseline := sink.DeepCopyObject()
+		if err := baseline.ConvertTo(ctx, hubBaseline); err != nil {
+			return &apis.FieldError{Message: "Failed to convert baseline", Details: err.Error()}
+		}
+		ctx = apis.WithinUpdate(ctx, hubBaseline)
+	}
+
+	if err := source.ConvertTo(ctx, sink); err != nil {
+		return &apis.FieldError{Message: "Failed to convert", Details: err.Error()}
+	}
+	return sink.Validate(ctx)
+}
+
+func setDefaultsViaHub(ctx context.Context, source convertible, sink hub) {
+	if err := source.ConvertTo(ctx, sink); err != nil {
+		return
+	}
+	sink.SetDefaults(ctx)
+	_ = source.ConvertFrom(ctx, sink)
+}
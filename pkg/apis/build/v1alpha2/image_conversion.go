@@ -0,0 +1,144 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 Image sink.
+func (i *Image) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.Image:
+		source := i.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 Image.
+func (i *Image) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.Image:
+		source = source.DeepCopy()
+		i.ObjectMeta = source.ObjectMeta
+		i.Spec.convertFrom(&source.Spec)
+		i.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (is *ImageSpec) convertTo(sink *v1alpha1.ImageSpec) {
+	sink.Tag = is.Tag
+	sink.Builder = v1alpha1.ImageBuilder{
+		TypeMeta: is.Builder.TypeMeta,
+		Name:     is.Builder.Name,
+	}
+	sink.ServiceAccount = is.ServiceAccount
+	is.Source.convertTo(&sink.Source)
+	sink.CacheSize = is.CacheSize
+	sink.FailedBuildHistoryLimit = is.FailedBuildHistoryLimit
+	sink.SuccessBuildHistoryLimit = is.SuccessBuildHistoryLimit
+	sink.ImageTaggingStrategy = v1alpha1.ImageTaggingStrategy(is.ImageTaggingStrategy)
+	sink.TagTemplates = is.TagTemplates
+	sink.AdditionalTags = is.AdditionalTags
+	sink.ImageLabels = is.ImageLabels
+	sink.Platforms = is.Platforms
+	sink.Build = v1alpha1.ImageBuild{
+		Env:       is.Build.Env,
+		Resources: is.Build.Resources,
+	}
+	if is.RunImage != nil {
+		sink.RunImage = &v1alpha1.ImageRunImage{
+			Image: is.RunImage.Image,
+			Stack: is.RunImage.Stack,
+		}
+	}
+	if is.RegistryCache != nil {
+		sink.RegistryCache = &v1alpha1.ImageRegistryCache{
+			Tag: is.RegistryCache.Tag,
+		}
+	}
+	if is.Signing != nil {
+		sink.Signing = &v1alpha1.ImageSigning{
+			Secret: is.Signing.Secret,
+		}
+	}
+	if is.Retention != nil {
+		sink.Retention = &v1alpha1.ImageRetention{
+			DeleteImages: is.Retention.DeleteImages,
+		}
+	}
+}
+
+func (is *ImageSpec) convertFrom(source *v1alpha1.ImageSpec) {
+	is.Tag = source.Tag
+	is.Builder = ImageBuilder{
+		TypeMeta: source.Builder.TypeMeta,
+		Name:     source.Builder.Name,
+	}
+	is.ServiceAccount = source.ServiceAccount
+	is.Source.convertFrom(&source.Source)
+	is.CacheSize = source.CacheSize
+	is.FailedBuildHistoryLimit = source.FailedBuildHistoryLimit
+	is.SuccessBuildHistoryLimit = source.SuccessBuildHistoryLimit
+	is.ImageTaggingStrategy = ImageTaggingStrategy(source.ImageTaggingStrategy)
+	is.TagTemplates = source.TagTemplates
+	is.AdditionalTags = source.AdditionalTags
+	is.ImageLabels = source.ImageLabels
+	is.Platforms = source.Platforms
+	is.Build = ImageBuild{
+		Env:       source.Build.Env,
+		Resources: source.Build.Resources,
+	}
+	if source.RunImage != nil {
+		is.RunImage = &ImageRunImage{
+			Image: source.RunImage.Image,
+			Stack: source.RunImage.Stack,
+		}
+	}
+	if source.RegistryCache != nil {
+		is.RegistryCache = &ImageRegistryCache{
+			Tag: source.RegistryCache.Tag,
+		}
+	}
+	if source.Signing != nil {
+		is.Signing = &ImageSigning{
+			Secret: source.Signing.Secret,
+		}
+	}
+	if source.Retention != nil {
+		is.Retention = &ImageRetention{
+			DeleteImages: source.Retention.DeleteImages,
+		}
+	}
+}
+
+func (is *ImageStatus) convertTo(sink *v1alpha1.ImageStatus) {
+	sink.Status = is.Status
+	sink.LatestBuildRef = is.LatestBuildRef
+	sink.LatestImage = is.LatestImage
+	sink.BuildCounter = is.BuildCounter
+	sink.BuildCacheName = is.BuildCacheName
+	sink.RunImage = is.RunImage
+	sink.BuildCacheSize = is.BuildCacheSize
+}
+
+func (is *ImageStatus) convertFrom(source *v1alpha1.ImageStatus) {
+	is.Status = source.Status
+	is.LatestBuildRef = source.LatestBuildRef
+	is.LatestImage = source.LatestImage
+	is.BuildCounter = source.BuildCounter
+	is.BuildCacheName = source.BuildCacheName
+	is.RunImage = source.RunImage
+	is.BuildCacheSize = source.BuildCacheSize
+}
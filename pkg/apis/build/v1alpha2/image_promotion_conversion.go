@@ -0,0 +1,64 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 ImagePromotion sink.
+func (p *ImagePromotion) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.ImagePromotion:
+		source := p.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 ImagePromotion.
+func (p *ImagePromotion) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.ImagePromotion:
+		source = source.DeepCopy()
+		p.ObjectMeta = source.ObjectMeta
+		p.Spec.convertFrom(&source.Spec)
+		p.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (ps *ImagePromotionSpec) convertTo(sink *v1alpha1.ImagePromotionSpec) {
+	sink.Image = ps.Image
+	sink.Build = ps.Build
+	sink.Tag = ps.Tag
+	sink.ServiceAccount = ps.ServiceAccount
+}
+
+func (ps *ImagePromotionSpec) convertFrom(source *v1alpha1.ImagePromotionSpec) {
+	ps.Image = source.Image
+	ps.Build = source.Build
+	ps.Tag = source.Tag
+	ps.ServiceAccount = source.ServiceAccount
+}
+
+func (ps *ImagePromotionStatus) convertTo(sink *v1alpha1.ImagePromotionStatus) {
+	sink.Status = ps.Status
+	sink.SourceImage = ps.SourceImage
+	sink.PromotedImage = ps.PromotedImage
+}
+
+func (ps *ImagePromotionStatus) convertFrom(source *v1alpha1.ImagePromotionStatus) {
+	ps.Status = source.Status
+	ps.SourceImage = source.SourceImage
+	ps.PromotedImage = source.PromotedImage
+}
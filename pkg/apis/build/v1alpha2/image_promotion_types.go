@@ -0,0 +1,73 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const ImagePromotionKind = "ImagePromotion"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type ImagePromotion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImagePromotionSpec   `json:"spec"`
+	Status ImagePromotionStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*ImagePromotion)(nil)
+	_ apis.Defaultable = (*ImagePromotion)(nil)
+)
+
+// ImagePromotionSpec copies the image of an Image or a Build to another tag.
+// Promotions of an Image follow its latest image, promotions of a Build copy
+// the image of that build only.
+type ImagePromotionSpec struct {
+	Image string `json:"image,omitempty"`
+	Build string `json:"build,omitempty"`
+	Tag   string `json:"tag"`
+	// ServiceAccount is used to pull and push the image. Defaults to the
+	// service account of the promoted Image or Build.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+type ImagePromotionStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	SourceImage         string `json:"sourceImage,omitempty"`
+	PromotedImage       string `json:"promotedImage,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImagePromotionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ImagePromotion `json:"items"`
+}
+
+func (*ImagePromotion) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(ImagePromotionKind)
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageSpec   `json:"spec"`
+	Status ImageStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Image)(nil)
+	_ apis.Defaultable = (*Image)(nil)
+)
+
+type ImageSpec struct {
+	Tag                      string               `json:"tag"`
+	Builder                  ImageBuilder         `json:"builder"`
+	ServiceAccount           string               `json:"serviceAccount"`
+	Source                   SourceConfig         `json:"source"`
+	CacheSize                *resource.Quantity   `json:"cacheSize,omitempty"`
+	FailedBuildHistoryLimit  *int64               `json:"failedBuildHistoryLimit"`
+	SuccessBuildHistoryLimit *int64               `json:"successBuildHistoryLimit"`
+	ImageTaggingStrategy     ImageTaggingStrategy `json:"imageTaggingStrategy"`
+	TagTemplates             []string             `json:"tagTemplates,omitempty"`
+	AdditionalTags           []string             `json:"additionalTags,omitempty"`
+	ImageLabels              map[string]string    `json:"imageLabels,omitempty"`
+	Platforms                []string             `json:"platforms,omitempty"`
+	Build                    ImageBuild           `json:"build"`
+	RunImage                 *ImageRunImage       `json:"runImage,omitempty"`
+	RegistryCache            *ImageRegistryCache  `json:"registryCache,omitempty"`
+	Signing                  *ImageSigning        `json:"signing,omitempty"`
+	Retention                *ImageRetention      `json:"retention,omitempty"`
+}
+
+type ImageBuilder struct {
+	metav1.TypeMeta `json:",inline"`
+	Name            string `json:"name"`
+}
+
+type ImageTaggingStrategy string
+
+const (
+	None        ImageTaggingStrategy = "None"
+	BuildNumber ImageTaggingStrategy = "BuildNumber"
+	// Template tags every build with the TagTemplates of the Image.
+	Template ImageTaggingStrategy = "Template"
+)
+
+// ImageRunImage selects the run image of an Image independently of the builder
+// metadata. Builds are rebased when the selected run image changes.
+type ImageRunImage struct {
+	// Image is a run image tag that is polled for new versions.
+	Image string `json:"image,omitempty"`
+	// Stack is the name of a Stack whose run image is used.
+	Stack string `json:"stack,omitempty"`
+}
+
+// ImageRegistryCache stores the build cache as an image in a registry instead
+// of a persistent volume claim.
+type ImageRegistryCache struct {
+	// Tag is the cache image tag. Defaults to the image tag suffixed with -cache.
+	Tag string `json:"tag,omitempty"`
+}
+
+// ImageSigning signs every built image and pushes a provenance attestation for
+// it with the key in the referenced Secret.
+type ImageSigning struct {
+	// Secret is the name of a Secret in the namespace of the Image containing
+	// a PEM encoded ECDSA private key under key.pem.
+	Secret string `json:"secret"`
+}
+
+// ImageRetention configures the cleanup of builds that exceed the build
+// history limits.
+type ImageRetention struct {
+	// DeleteImages deletes the images of removed builds from the registry
+	// together with their build number tags. Images still referenced by the
+	// image tag or by a retained build are kept.
+	DeleteImages bool `json:"deleteImages,omitempty"`
+}
+
+type ImageBuild struct {
+	Env       []corev1.EnvVar             `json:"env"`
+	Resources corev1.ResourceRequirements `json:"resources"`
+}
+
+type ImageStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	LatestBuildRef      string             `json:"latestBuildRef"`
+	LatestImage         string             `json:"latestImage"`
+	BuildCounter        int64              `json:"buildCounter"`
+	BuildCacheName      string             `json:"buildCacheName"`
+	RunImage            string             `json:"runImage,omitempty"`
+	BuildCacheSize      *resource.Quantity `json:"buildCacheSize,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Image `json:"items"`
+}
+
+func (*Image) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Image")
+}
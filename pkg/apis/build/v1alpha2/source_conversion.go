@@ -0,0 +1,117 @@
+package v1alpha2
+
+import (
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func (sc *SourceConfig) convertTo(sink *v1alpha1.SourceConfig) {
+	sink.Git = nil
+	if sc.Git != nil {
+		sink.Git = &v1alpha1.Git{
+			URL:      sc.Git.URL,
+			Revision: sc.Git.Revision,
+		}
+	}
+
+	sink.Blob = nil
+	if sc.Blob != nil {
+		sink.Blob = &v1alpha1.Blob{
+			URL: sc.Blob.URL,
+		}
+	}
+
+	sink.Registry = nil
+	if sc.Registry != nil {
+		sink.Registry = &v1alpha1.Registry{
+			Image:            sc.Registry.Image,
+			ImagePullSecrets: sc.Registry.ImagePullSecrets,
+		}
+	}
+
+	sink.SubPath = sc.SubPath
+}
+
+func (sc *SourceConfig) convertFrom(source *v1alpha1.SourceConfig) {
+	sc.Git = nil
+	if source.Git != nil {
+		sc.Git = &Git{
+			URL:      source.Git.URL,
+			Revision: source.Git.Revision,
+		}
+	}
+
+	sc.Blob = nil
+	if source.Blob != nil {
+		sc.Blob = &Blob{
+			URL: source.Blob.URL,
+		}
+	}
+
+	sc.Registry = nil
+	if source.Registry != nil {
+		sc.Registry = &Registry{
+			Image:            source.Registry.Image,
+			ImagePullSecrets: source.Registry.ImagePullSecrets,
+		}
+	}
+
+	sc.SubPath = source.SubPath
+}
+
+func (sc *ResolvedSourceConfig) convertTo(sink *v1alpha1.ResolvedSourceConfig) {
+	sink.Git = nil
+	if sc.Git != nil {
+		sink.Git = &v1alpha1.ResolvedGitSource{
+			URL:      sc.Git.URL,
+			Revision: sc.Git.Revision,
+			SubPath:  sc.Git.SubPath,
+			Type:     v1alpha1.GitSourceKind(sc.Git.Type),
+		}
+	}
+
+	sink.Blob = nil
+	if sc.Blob != nil {
+		sink.Blob = &v1alpha1.ResolvedBlobSource{
+			URL:     sc.Blob.URL,
+			SubPath: sc.Blob.SubPath,
+		}
+	}
+
+	sink.Registry = nil
+	if sc.Registry != nil {
+		sink.Registry = &v1alpha1.ResolvedRegistrySource{
+			Image:            sc.Registry.Image,
+			ImagePullSecrets: sc.Registry.ImagePullSecrets,
+			SubPath:          sc.Registry.SubPath,
+		}
+	}
+}
+
+func (sc *ResolvedSourceConfig) convertFrom(source *v1alpha1.ResolvedSourceConfig) {
+	sc.Git = nil
+	if source.Git != nil {
+		sc.Git = &ResolvedGitSource{
+			URL:      source.Git.URL,
+			Revision: source.Git.Revision,
+			SubPath:  source.Git.SubPath,
+			Type:     GitSourceKind(source.Git.Type),
+		}
+	}
+
+	sc.Blob = nil
+	if source.Blob != nil {
+		sc.Blob = &ResolvedBlobSource{
+			URL:     source.Blob.URL,
+			SubPath: source.Blob.SubPath,
+		}
+	}
+
+	sc.Registry = nil
+	if source.Registry != nil {
+		sc.Registry = &ResolvedRegistrySource{
+			Image:            source.Registry.Image,
+			ImagePullSecrets: source.Registry.ImagePullSecrets,
+			SubPath:          source.Registry.SubPath,
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 SourceResolver sink.
+func (sr *SourceResolver) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.SourceResolver:
+		source := sr.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		sink.Spec.ServiceAccount = source.Spec.ServiceAccount
+		source.Spec.Source.convertTo(&sink.Spec.Source)
+		sink.Status.Status = source.Status.Status
+		source.Status.Source.convertTo(&sink.Status.Source)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 SourceResolver.
+func (sr *SourceResolver) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.SourceResolver:
+		source = source.DeepCopy()
+		sr.ObjectMeta = source.ObjectMeta
+		sr.Spec.ServiceAccount = source.Spec.ServiceAccount
+		sr.Spec.Source.convertFrom(&source.Spec.Source)
+		sr.Status.Status = source.Status.Status
+		sr.Status.Source.convertFrom(&source.Status.Source)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
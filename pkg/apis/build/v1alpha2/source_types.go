@@ -0,0 +1,59 @@
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+type SourceConfig struct {
+	Git      *Git      `json:"git,omitempty"`
+	Blob     *Blob     `json:"blob,omitempty"`
+	Registry *Registry `json:"registry,omitempty"`
+	SubPath  string    `json:"subPath,omitempty"`
+}
+
+type Git struct {
+	URL      string `json:"url"`
+	Revision string `json:"revision"`
+}
+
+type Blob struct {
+	URL string `json:"url"`
+}
+
+type Registry struct {
+	Image            string                        `json:"image"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+}
+
+type ResolvedSourceConfig struct {
+	Git      *ResolvedGitSource      `json:"git,omitempty"`
+	Blob     *ResolvedBlobSource     `json:"blob,omitempty"`
+	Registry *ResolvedRegistrySource `json:"registry,omitempty"`
+}
+
+type GitSourceKind string
+
+const (
+	Unknown GitSourceKind = "Unknown"
+	Branch  GitSourceKind = "Branch"
+	Tag     GitSourceKind = "Tag"
+	Commit  GitSourceKind = "Commit"
+)
+
+type ResolvedGitSource struct {
+	URL      string        `json:"url"`
+	Revision string        `json:"revision"`
+	SubPath  string        `json:"subPath,omitempty"`
+	Type     GitSourceKind `json:"type"`
+}
+
+type ResolvedBlobSource struct {
+	URL     string `json:"url"`
+	SubPath string `json:"subPath,omitempty"`
+}
+
+type ResolvedRegistrySource struct {
+	Image            string                        `json:"image"`
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+	SubPath          string                        `json:"subPath,omitempty"`
+}
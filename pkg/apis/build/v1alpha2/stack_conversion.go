@@ -0,0 +1,70 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 Stack sink.
+func (s *Stack) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.Stack:
+		source := s.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 Stack.
+func (s *Stack) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.Stack:
+		source = source.DeepCopy()
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (ss *StackSpec) convertTo(sink *v1alpha1.StackSpec) {
+	sink.ID = ss.ID
+	sink.BuildImage = v1alpha1.StackSpecImage(ss.BuildImage)
+	sink.RunImage = v1alpha1.StackSpecImage(ss.RunImage)
+	sink.Mixins = ss.Mixins
+	sink.ServiceAccountRef = ss.ServiceAccountRef
+}
+
+func (ss *StackSpec) convertFrom(source *v1alpha1.StackSpec) {
+	ss.ID = source.ID
+	ss.BuildImage = StackSpecImage(source.BuildImage)
+	ss.RunImage = StackSpecImage(source.RunImage)
+	ss.Mixins = source.Mixins
+	ss.ServiceAccountRef = source.ServiceAccountRef
+}
+
+func (ss *StackStatus) convertTo(sink *v1alpha1.StackStatus) {
+	sink.Status = ss.Status
+	sink.ID = ss.ID
+	sink.BuildImage = v1alpha1.StackStatusImage(ss.BuildImage)
+	sink.RunImage = v1alpha1.StackStatusImage(ss.RunImage)
+	sink.Mixins = ss.Mixins
+}
+
+func (ss *StackStatus) convertFrom(source *v1alpha1.StackStatus) {
+	ss.Status = source.Status
+	ss.ID = source.ID
+	ss.BuildImage = StackStatusImage(source.BuildImage)
+	ss.RunImage = StackStatusImage(source.RunImage)
+	ss.Mixins = source.Mixins
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const StackKind = "Stack"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec"`
+	Status StackStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Stack)(nil)
+	_ apis.Defaultable = (*Stack)(nil)
+)
+
+type StackSpec struct {
+	ID         string         `json:"id"`
+	BuildImage StackSpecImage `json:"buildImage"`
+	RunImage   StackSpecImage `json:"runImage"`
+	Mixins     []string       `json:"mixins,omitempty"`
+	// ServiceAccountRef is the namespaced service account whose secrets are
+	// used to pull the build and run images.
+	ServiceAccountRef *corev1.ObjectReference `json:"serviceAccountRef,omitempty"`
+}
+
+type StackSpecImage struct {
+	Image string `json:"image"`
+}
+
+type StackStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	ResolvedStack       `json:",inline"`
+}
+
+type ResolvedStack struct {
+	ID         string           `json:"id,omitempty"`
+	BuildImage StackStatusImage `json:"buildImage,omitempty"`
+	RunImage   StackStatusImage `json:"runImage,omitempty"`
+	Mixins     []string         `json:"mixins,omitempty"`
+}
+
+type StackStatusImage struct {
+	LatestImage string `json:"latestImage,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Stack `json:"items"`
+}
+
+func (*Stack) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StackKind)
+}
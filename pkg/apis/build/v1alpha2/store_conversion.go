@@ -0,0 +1,90 @@
+package v1alpha2
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// ConvertTo converts the receiver into the v1alpha1 Store sink.
+func (s *Store) ConvertTo(_ context.Context, to runtime.Object) error {
+	switch sink := to.(type) {
+	case *v1alpha1.Store:
+		source := s.DeepCopy()
+		sink.ObjectMeta = source.ObjectMeta
+		source.Spec.convertTo(&sink.Spec)
+		source.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom populates the receiver from a v1alpha1 Store.
+func (s *Store) ConvertFrom(_ context.Context, from runtime.Object) error {
+	switch source := from.(type) {
+	case *v1alpha1.Store:
+		source = source.DeepCopy()
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func (ss *StoreSpec) convertTo(sink *v1alpha1.StoreSpec) {
+	sink.Sources = nil
+	for _, s := range ss.Sources {
+		sink.Sources = append(sink.Sources, v1alpha1.StoreImage(s))
+	}
+	sink.ServiceAccountRef = ss.ServiceAccountRef
+}
+
+func (ss *StoreSpec) convertFrom(source *v1alpha1.StoreSpec) {
+	ss.Sources = nil
+	for _, s := range source.Sources {
+		ss.Sources = append(ss.Sources, StoreImage(s))
+	}
+	ss.ServiceAccountRef = source.ServiceAccountRef
+}
+
+func (ss *StoreStatus) convertTo(sink *v1alpha1.StoreStatus) {
+	sink.Status = ss.Status
+	sink.Buildpacks = nil
+	for _, bp := range ss.Buildpacks {
+		sinkBuildpack := v1alpha1.StoreBuildpack{
+			ID:         bp.ID,
+			Version:    bp.Version,
+			StoreImage: v1alpha1.StoreImage(bp.StoreImage),
+			DiffID:     bp.DiffID,
+			API:        bp.API,
+		}
+		for _, stack := range bp.Stacks {
+			sinkBuildpack.Stacks = append(sinkBuildpack.Stacks, v1alpha1.BuildpackStack(stack))
+		}
+		sink.Buildpacks = append(sink.Buildpacks, sinkBuildpack)
+	}
+}
+
+func (ss *StoreStatus) convertFrom(source *v1alpha1.StoreStatus) {
+	ss.Status = source.Status
+	ss.Buildpacks = nil
+	for _, bp := range source.Buildpacks {
+		buildpack := StoreBuildpack{
+			ID:         bp.ID,
+			Version:    bp.Version,
+			StoreImage: StoreImage(bp.StoreImage),
+			DiffID:     bp.DiffID,
+			API:        bp.API,
+		}
+		for _, stack := range bp.Stacks {
+			buildpack.Stacks = append(buildpack.Stacks, BuildpackStack(stack))
+		}
+		ss.Buildpacks = append(ss.Buildpacks, buildpack)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+)
+
+const StoreKind = "Store"
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+type Store struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StoreSpec   `json:"spec"`
+	Status StoreStatus `json:"status"`
+}
+
+var (
+	_ apis.Validatable = (*Store)(nil)
+	_ apis.Defaultable = (*Store)(nil)
+)
+
+type StoreSpec struct {
+	Sources []StoreImage `json:"sources"`
+	// ServiceAccountRef is the namespaced service account whose secrets are
+	// used to pull the source images.
+	ServiceAccountRef *corev1.ObjectReference `json:"serviceAccountRef,omitempty"`
+}
+
+type StoreStatus struct {
+	duckv1alpha1.Status `json:",inline"`
+	Buildpacks          []StoreBuildpack `json:"buildpacks,omitempty"`
+}
+
+type StoreBuildpack struct {
+	ID         string           `json:"id"`
+	Version    string           `json:"version"`
+	StoreImage StoreImage       `json:"storeImage"`
+	DiffID     string           `json:"diffId"`
+	API        string           `json:"api,omitempty"`
+	Stacks     []BuildpackStack `json:"stacks,omitempty"`
+}
+
+type BuildpackStack struct {
+	ID string `json:"id"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type StoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Store `json:"items"`
+}
+
+func (*Store) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(StoreKind)
+}
@@ -0,0 +1,45 @@
+package v1alpha2
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func (b *Build) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, b, &v1alpha1.Build{})
+}
+
+func (i *Image) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, i, &v1alpha1.Image{})
+}
+
+func (b *Builder) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, b, &v1alpha1.Builder{})
+}
+
+func (c *ClusterBuilder) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, c, &v1alpha1.ClusterBuilder{})
+}
+
+func (c *CustomBuilder) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, c, &v1alpha1.CustomBuilder{})
+}
+
+func (s *Stack) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, s, &v1alpha1.Stack{})
+}
+
+func (s *Store) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, s, &v1alpha1.Store{})
+}
+
+func (p *ImagePromotion) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, p, &v1alpha1.ImagePromotion{})
+}
+
+func (sr *SourceResolver) Validate(ctx context.Context) *apis.FieldError {
+	return validateViaHub(ctx, sr, &v1alpha1.SourceResolver{})
+}
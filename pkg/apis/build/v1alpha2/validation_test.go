@@ -0,0 +1,73 @@
+package v1alpha2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+)
+
+func TestValidation(t *testing.T) {
+	spec.Run(t, "Validation", testValidation)
+}
+
+func testValidation(t *testing.T, when spec.G, it spec.S) {
+	image := &v1alpha2.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "image-name",
+		},
+		Spec: v1alpha2.ImageSpec{
+			Tag: "some/image",
+			Builder: v1alpha2.ImageBuilder{
+				Name: "some-builder",
+			},
+			Source: v1alpha2.SourceConfig{
+				Git: &v1alpha2.Git{
+					URL:      "http://github.com/repo",
+					Revision: "master",
+				},
+			},
+		},
+	}
+
+	it("applies the v1alpha1 defaults", func() {
+		image.SetDefaults(context.TODO())
+
+		assert.Equal(t, "default", image.Spec.ServiceAccount)
+		assert.Equal(t, v1alpha2.BuilderKind, image.Spec.Builder.Kind)
+		assert.Equal(t, v1alpha2.BuildNumber, image.Spec.ImageTaggingStrategy)
+		assert.Equal(t, "image-name", image.Name)
+	})
+
+	it("applies the v1alpha1 validation", func() {
+		image.SetDefaults(context.TODO())
+		assert.Nil(t, image.Validate(context.TODO()))
+
+		image.Spec.Tag = ""
+		assert.EqualError(t, image.Validate(context.TODO()), apis.ErrMissingField("tag").ViaField("spec").Error())
+	})
+
+	it("converts the baseline of updates", func() {
+		build := &v1alpha2.Build{
+			Spec: v1alpha2.BuildSpec{
+				Tags: []string{"some/image"},
+				Builder: v1alpha2.BuildBuilderSpec{
+					Image: "some/builder",
+				},
+				Source: v1alpha2.SourceConfig{
+					Blob: &v1alpha2.Blob{URL: "https://some.blob"},
+				},
+			},
+		}
+		original := build.DeepCopy()
+		build.Spec.Tags = []string{"some/other-image"}
+
+		err := build.Validate(apis.WithinUpdate(context.TODO(), original))
+		assert.Contains(t, err.Error(), "Immutable fields changed (-old +new): spec")
+	})
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	DefaultsConfigName = "config-defaults"
+
+	defaultCacheSizeKey = "default-cache-size"
+)
+
+// Defaults holds the cluster-wide defaults applied to kpack resources at admission.
+type Defaults struct {
+	// CacheSize is applied to Images that do not specify a cache size. A nil
+	// CacheSize leaves the Image without a build cache.
+	CacheSize *resource.Quantity
+}
+
+func NewDefaultsConfigFromMap(data map[string]string) (*Defaults, error) {
+	defaults := &Defaults{}
+
+	if raw, ok := data[defaultCacheSizeKey]; ok && raw != "" {
+		cacheSize, err := resource.ParseQuantity(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %s", defaultCacheSizeKey)
+		}
+		if cacheSize.Sign() <= 0 {
+			return nil, errors.Errorf("%s must be greater than zero, got %s", defaultCacheSizeKey, raw)
+		}
+		defaults.CacheSize = &cacheSize
+	}
+
+	return defaults, nil
+}
+
+func NewDefaultsConfigFromConfigMap(configMap *corev1.ConfigMap) (*Defaults, error) {
+	return NewDefaultsConfigFromMap(configMap.Data)
+}
@@ -0,0 +1,63 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/config"
+)
+
+func TestDefaults(t *testing.T) {
+	spec.Run(t, "Defaults", testDefaults)
+}
+
+func testDefaults(t *testing.T, when spec.G, it spec.S) {
+	configMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      config.DefaultsConfigName,
+				Namespace: "kpack",
+			},
+			Data: data,
+		}
+	}
+
+	when("#NewDefaultsConfigFromConfigMap", func() {
+		it("parses the default cache size", func() {
+			defaults, err := config.NewDefaultsConfigFromConfigMap(configMap(map[string]string{
+				"default-cache-size": "2G",
+			}))
+			require.NoError(t, err)
+
+			expected := resource.MustParse("2G")
+			assert.Equal(t, &expected, defaults.CacheSize)
+		})
+
+		it("leaves the cache size unset when not configured", func() {
+			defaults, err := config.NewDefaultsConfigFromConfigMap(configMap(nil))
+			require.NoError(t, err)
+
+			assert.Nil(t, defaults.CacheSize)
+		})
+
+		it("errors on an invalid cache size", func() {
+			_, err := config.NewDefaultsConfigFromConfigMap(configMap(map[string]string{
+				"default-cache-size": "lots",
+			}))
+			assert.Error(t, err)
+		})
+
+		it("errors on a non positive cache size", func() {
+			_, err := config.NewDefaultsConfigFromConfigMap(configMap(map[string]string{
+				"default-cache-size": "0",
+			}))
+			assert.EqualError(t, err, "default-cache-size must be greater than zero, got 0")
+		})
+	})
+}
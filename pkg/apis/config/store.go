@@ -0,0 +1,57 @@
+package config
+
+import (
+	"context"
+
+	"knative.dev/pkg/configmap"
+)
+
+type cfgKey struct{}
+
+type Config struct {
+	Defaults *Defaults
+}
+
+func FromContext(ctx context.Context) *Config {
+	cfg, _ := ctx.Value(cfgKey{}).(*Config)
+	return cfg
+}
+
+// FromContextOrDefaults returns the Config stored in the context or an empty
+// Config if the context has none, so callers never have to nil check.
+func FromContextOrDefaults(ctx context.Context) *Config {
+	if cfg := FromContext(ctx); cfg != nil && cfg.Defaults != nil {
+		return cfg
+	}
+	return &Config{Defaults: &Defaults{}}
+}
+
+func ToContext(ctx context.Context, c *Config) context.Context {
+	return context.WithValue(ctx, cfgKey{}, c)
+}
+
+type Store struct {
+	*configmap.UntypedStore
+}
+
+func NewStore(logger configmap.Logger, onAfterStore ...func(name string, value interface{})) *Store {
+	return &Store{
+		UntypedStore: configmap.NewUntypedStore(
+			"defaults",
+			logger,
+			configmap.Constructors{
+				DefaultsConfigName: NewDefaultsConfigFromConfigMap,
+			},
+			onAfterStore...,
+		),
+	}
+}
+
+func (s *Store) ToContext(ctx context.Context) context.Context {
+	return ToContext(ctx, s.Load())
+}
+
+func (s *Store) Load() *Config {
+	defaults, _ := s.UntypedLoad(DefaultsConfigName).(*Defaults)
+	return &Config{Defaults: defaults}
+}
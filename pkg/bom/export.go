@@ -0,0 +1,193 @@
+package bom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const (
+	CycloneDXFormat = "cyclonedx"
+	SPDXFormat      = "spdx"
+)
+
+// Export encodes the bill of materials of a successful build in the requested format.
+func Export(build *v1alpha1.Build, format string) ([]byte, error) {
+	switch format {
+	case CycloneDXFormat:
+		return CycloneDX(build)
+	case SPDXFormat:
+		return SPDX(build)
+	default:
+		return nil, fmt.Errorf("unsupported bill of materials format %s", format)
+	}
+}
+
+type cycloneDXDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp,omitempty"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXComponent struct {
+	Type     string             `json:"type"`
+	Name     string             `json:"name"`
+	Version  string             `json:"version,omitempty"`
+	Hashes   []cycloneDXHash    `json:"hashes,omitempty"`
+	Licenses []cycloneDXLicense `json:"licenses,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+type cycloneDXLicense struct {
+	License cycloneDXLicenseName `json:"license"`
+}
+
+type cycloneDXLicenseName struct {
+	Name string `json:"name"`
+}
+
+// CycloneDX encodes the bill of materials of a build as a CycloneDX 1.2 JSON document.
+func CycloneDX(build *v1alpha1.Build) ([]byte, error) {
+	imageName, imageDigest := builtImage(build)
+
+	document := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.2",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: completionTime(build),
+			Component: cycloneDXComponent{
+				Type:    "container",
+				Name:    imageName,
+				Version: imageDigest,
+			},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, entry := range build.Status.BOM {
+		component := cycloneDXComponent{
+			Type:    "library",
+			Name:    entry.Name,
+			Version: entry.Version,
+		}
+		if entry.SHA256 != "" {
+			component.Hashes = []cycloneDXHash{{Algorithm: "SHA-256", Content: entry.SHA256}}
+		}
+		for _, license := range entry.Licenses {
+			component.Licenses = append(component.Licenses, cycloneDXLicense{License: cycloneDXLicenseName{Name: license}})
+		}
+		document.Components = append(document.Components, component)
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+type spdxDocument struct {
+	SPDXVersion       string           `json:"spdxVersion"`
+	DataLicense       string           `json:"dataLicense"`
+	SPDXID            string           `json:"SPDXID"`
+	Name              string           `json:"name"`
+	DocumentNamespace string           `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo `json:"creationInfo"`
+	Packages          []spdxPackage    `json:"packages"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string         `json:"SPDXID"`
+	Name             string         `json:"name"`
+	VersionInfo      string         `json:"versionInfo,omitempty"`
+	Supplier         string         `json:"supplier,omitempty"`
+	DownloadLocation string         `json:"downloadLocation"`
+	Checksums        []spdxChecksum `json:"checksums,omitempty"`
+	LicenseConcluded string         `json:"licenseConcluded"`
+	LicenseDeclared  string         `json:"licenseDeclared"`
+	CopyrightText    string         `json:"copyrightText"`
+}
+
+type spdxChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+const spdxNoAssertion = "NOASSERTION"
+
+// SPDX encodes the bill of materials of a build as an SPDX 2.2 JSON document.
+func SPDX(build *v1alpha1.Build) ([]byte, error) {
+	imageName, imageDigest := builtImage(build)
+
+	document := spdxDocument{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              imageName,
+		DocumentNamespace: fmt.Sprintf("https://kpack.io/spdx/%s/%s/%s", build.Namespace, build.Name, strings.TrimPrefix(imageDigest, "sha256:")),
+		CreationInfo: spdxCreationInfo{
+			Created:  completionTime(build),
+			Creators: []string{"Tool: kpack"},
+		},
+		Packages: []spdxPackage{},
+	}
+
+	for i, entry := range build.Status.BOM {
+		pkg := spdxPackage{
+			SPDXID:           fmt.Sprintf("SPDXRef-Package-%d", i+1),
+			Name:             entry.Name,
+			VersionInfo:      entry.Version,
+			Supplier:         fmt.Sprintf("Organization: %s", entry.Buildpack.ID),
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+		}
+		if entry.URI != "" {
+			pkg.DownloadLocation = entry.URI
+		}
+		if entry.SHA256 != "" {
+			pkg.Checksums = []spdxChecksum{{Algorithm: "SHA256", Value: entry.SHA256}}
+		}
+		if len(entry.Licenses) > 0 {
+			pkg.LicenseDeclared = strings.Join(entry.Licenses, " AND ")
+		}
+		document.Packages = append(document.Packages, pkg)
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+// builtImage is the repository and digest of the image built by a build.
+func builtImage(build *v1alpha1.Build) (string, string) {
+	digest, err := name.NewDigest(build.Status.LatestImage, name.WeakValidation)
+	if err != nil {
+		return build.Tag(), ""
+	}
+	return digest.Context().Name(), digest.DigestStr()
+}
+
+func completionTime(build *v1alpha1.Build) string {
+	if build.Status.CompletionTime == nil {
+		return build.CreationTimestamp.UTC().Format(time.RFC3339)
+	}
+	return build.Status.CompletionTime.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,132 @@
+package bom_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/bom"
+)
+
+func TestExport(t *testing.T) {
+	spec.Run(t, "Export", testExport)
+}
+
+func testExport(t *testing.T, when spec.G, it spec.S) {
+	completionTime := metav1.NewTime(time.Date(2019, 10, 21, 12, 0, 0, 0, time.UTC))
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image-build-1",
+			Namespace: "some-namespace",
+		},
+		Spec: v1alpha1.BuildSpec{
+			Tags: []string{"some.registry.io/some-image"},
+		},
+		Status: v1alpha1.BuildStatus{
+			LatestImage:    "some.registry.io/some-image@sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4",
+			CompletionTime: &completionTime,
+			BOM: []v1alpha1.BOMEntry{
+				{
+					Name:      "openjdk-jre",
+					Version:   "11.0.5",
+					Buildpack: v1alpha1.BOMBuildpack{ID: "org.cloudfoundry.openjdk", Version: "1.0.0"},
+					SHA256:    "2b8bd3e9a6b1e1c8c0a0bd7f7e5f7b0c1d4f2f8b2d3b8f6c9a1e0d4b7c6a5f3e",
+					URI:       "https://example.com/openjdk.tar.gz",
+					Licenses:  []string{"GPL-2.0", "Classpath-exception-2.0"},
+				},
+				{
+					Name:      "express",
+					Version:   "4.17.1",
+					Buildpack: v1alpha1.BOMBuildpack{ID: "org.cloudfoundry.npm", Version: "0.0.2"},
+				},
+			},
+		},
+	}
+
+	when("#CycloneDX", func() {
+		it("exports the bill of materials as CycloneDX components of the image", func() {
+			document, err := bom.Export(build, bom.CycloneDXFormat)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.2",
+  "version": 1,
+  "metadata": {
+    "timestamp": "2019-10-21T12:00:00Z",
+    "component": {
+      "type": "container",
+      "name": "some.registry.io/some-image",
+      "version": "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"
+    }
+  },
+  "components": [
+    {
+      "type": "library",
+      "name": "openjdk-jre",
+      "version": "11.0.5",
+      "hashes": [{"alg": "SHA-256", "content": "2b8bd3e9a6b1e1c8c0a0bd7f7e5f7b0c1d4f2f8b2d3b8f6c9a1e0d4b7c6a5f3e"}],
+      "licenses": [{"license": {"name": "GPL-2.0"}}, {"license": {"name": "Classpath-exception-2.0"}}]
+    },
+    {
+      "type": "library",
+      "name": "express",
+      "version": "4.17.1"
+    }
+  ]
+}`, string(document))
+		})
+	})
+
+	when("#SPDX", func() {
+		it("exports the bill of materials as SPDX packages", func() {
+			document, err := bom.Export(build, bom.SPDXFormat)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, `{
+  "spdxVersion": "SPDX-2.2",
+  "dataLicense": "CC0-1.0",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "some.registry.io/some-image",
+  "documentNamespace": "https://kpack.io/spdx/some-namespace/some-image-build-1/dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4",
+  "creationInfo": {
+    "created": "2019-10-21T12:00:00Z",
+    "creators": ["Tool: kpack"]
+  },
+  "packages": [
+    {
+      "SPDXID": "SPDXRef-Package-1",
+      "name": "openjdk-jre",
+      "versionInfo": "11.0.5",
+      "supplier": "Organization: org.cloudfoundry.openjdk",
+      "downloadLocation": "https://example.com/openjdk.tar.gz",
+      "checksums": [{"algorithm": "SHA256", "checksumValue": "2b8bd3e9a6b1e1c8c0a0bd7f7e5f7b0c1d4f2f8b2d3b8f6c9a1e0d4b7c6a5f3e"}],
+      "licenseConcluded": "NOASSERTION",
+      "licenseDeclared": "GPL-2.0 AND Classpath-exception-2.0",
+      "copyrightText": "NOASSERTION"
+    },
+    {
+      "SPDXID": "SPDXRef-Package-2",
+      "name": "express",
+      "versionInfo": "4.17.1",
+      "supplier": "Organization: org.cloudfoundry.npm",
+      "downloadLocation": "NOASSERTION",
+      "licenseConcluded": "NOASSERTION",
+      "licenseDeclared": "NOASSERTION",
+      "copyrightText": "NOASSERTION"
+    }
+  ]
+}`, string(document))
+		})
+	})
+
+	it("errors for an unknown format", func() {
+		_, err := bom.Export(build, "some-format")
+		require.EqualError(t, err, "unsupported bill of materials format some-format")
+	})
+}
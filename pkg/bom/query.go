@@ -0,0 +1,67 @@
+package bom
+
+import (
+	"strconv"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// Match is a dependency found in the bill of materials of the latest image of an Image.
+type Match struct {
+	Namespace  string
+	Image      string
+	Build      string
+	BuiltImage string
+	Entry      v1alpha1.BOMEntry
+}
+
+// LatestBuild is the most recent successful build that produced the latest
+// image of an image. Failed builds since then are skipped.
+func LatestBuild(image *v1alpha1.Image, builds []*v1alpha1.Build) *v1alpha1.Build {
+	var latest *v1alpha1.Build
+	for _, build := range builds {
+		if build.Namespace != image.Namespace || build.Labels[v1alpha1.ImageLabel] != image.Name {
+			continue
+		}
+
+		if !build.IsSuccess() || build.Status.LatestImage != image.Status.LatestImage {
+			continue
+		}
+
+		if latest == nil || buildNumber(build) > buildNumber(latest) {
+			latest = build
+		}
+	}
+	return latest
+}
+
+// Find lists the images whose latest build includes a dependency.
+func Find(images []*v1alpha1.Image, builds []*v1alpha1.Build, dependency string) []Match {
+	var matches []Match
+	for _, image := range images {
+		build := LatestBuild(image, builds)
+		if build == nil {
+			continue
+		}
+
+		for _, entry := range build.Status.BOM {
+			if entry.Name != dependency {
+				continue
+			}
+
+			matches = append(matches, Match{
+				Namespace:  image.Namespace,
+				Image:      image.Name,
+				Build:      build.Name,
+				BuiltImage: build.Status.LatestImage,
+				Entry:      entry,
+			})
+		}
+	}
+	return matches
+}
+
+func buildNumber(build *v1alpha1.Build) int64 {
+	number, _ := strconv.ParseInt(build.Labels[v1alpha1.BuildNumberLabel], 10, 64)
+	return number
+}
@@ -0,0 +1,105 @@
+package bom_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/bom"
+)
+
+func TestQuery(t *testing.T) {
+	spec.Run(t, "Query", testQuery)
+}
+
+func testQuery(t *testing.T, when spec.G, it spec.S) {
+	const latestImage = "some.registry.io/some-image@sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"
+
+	image := &v1alpha1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image",
+			Namespace: "some-namespace",
+		},
+		Status: v1alpha1.ImageStatus{
+			LatestImage: latestImage,
+		},
+	}
+
+	newBuild := func(number string, status corev1.ConditionStatus, builtImage string, bomEntries ...v1alpha1.BOMEntry) *v1alpha1.Build {
+		return &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-image-build-" + number,
+				Namespace: "some-namespace",
+				Labels: map[string]string{
+					v1alpha1.ImageLabel:       "some-image",
+					v1alpha1.BuildNumberLabel: number,
+				},
+			},
+			Status: v1alpha1.BuildStatus{
+				Status: duckv1alpha1.Status{
+					Conditions: duckv1alpha1.Conditions{
+						{Type: duckv1alpha1.ConditionSucceeded, Status: status},
+					},
+				},
+				LatestImage: builtImage,
+				BOM:         bomEntries,
+			},
+		}
+	}
+
+	openjdk := v1alpha1.BOMEntry{Name: "openjdk-jre", Version: "11.0.5"}
+	express := v1alpha1.BOMEntry{Name: "express", Version: "4.17.1"}
+
+	when("#LatestBuild", func() {
+		it("selects the most recent successful build of the latest image", func() {
+			builds := []*v1alpha1.Build{
+				newBuild("1", corev1.ConditionTrue, "some.registry.io/some-image@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"),
+				newBuild("3", corev1.ConditionTrue, latestImage),
+				newBuild("2", corev1.ConditionTrue, latestImage),
+				newBuild("4", corev1.ConditionFalse, ""),
+			}
+
+			assert.Equal(t, builds[1], bom.LatestBuild(image, builds))
+		})
+
+		it("ignores builds of other images", func() {
+			other := newBuild("1", corev1.ConditionTrue, latestImage)
+			other.Labels[v1alpha1.ImageLabel] = "other-image"
+
+			assert.Nil(t, bom.LatestBuild(image, []*v1alpha1.Build{other}))
+		})
+	})
+
+	when("#Find", func() {
+		it("lists the images whose latest build includes the dependency", func() {
+			builds := []*v1alpha1.Build{
+				newBuild("1", corev1.ConditionTrue, "some.registry.io/some-image@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", openjdk),
+				newBuild("2", corev1.ConditionTrue, latestImage, openjdk, express),
+			}
+
+			assert.Equal(t, []bom.Match{
+				{
+					Namespace:  "some-namespace",
+					Image:      "some-image",
+					Build:      "some-image-build-2",
+					BuiltImage: latestImage,
+					Entry:      express,
+				},
+			}, bom.Find([]*v1alpha1.Image{image}, builds, "express"))
+		})
+
+		it("does not match dependencies of previous images", func() {
+			builds := []*v1alpha1.Build{
+				newBuild("1", corev1.ConditionTrue, "some.registry.io/some-image@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", express),
+				newBuild("2", corev1.ConditionTrue, latestImage, openjdk),
+			}
+
+			assert.Empty(t, bom.Find([]*v1alpha1.Image{image}, builds, "express"))
+		})
+	})
+}
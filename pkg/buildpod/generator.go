@@ -9,13 +9,20 @@ import (
 	k8sclient "k8s.io/client-go/kubernetes"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
 	"github.com/pivotal/kpack/pkg/registry"
 )
 
+//go:generate counterfeiter . PlatformResolver
+type PlatformResolver interface {
+	Resolve(image, platform string, secretRef registry.SecretRef) (string, error)
+}
+
 type Generator struct {
 	BuildPodConfig     v1alpha1.BuildPodConfig
 	K8sClient          k8sclient.Interface
 	RemoteImageFactory registry.RemoteImageFactory
+	PlatformResolver   PlatformResolver
 }
 
 func (g *Generator) Generate(build *v1alpha1.Build) (*v1.Pod, error) {
@@ -24,12 +31,47 @@ func (g *Generator) Generate(build *v1alpha1.Build) (*v1.Pod, error) {
 		return nil, err
 	}
 
-	userAndGroup, err := g.fetchUserAndGroup(build)
+	builderConfig, err := g.fetchBuilderConfig(build)
 	if err != nil {
 		return nil, err
 	}
 
-	return build.BuildPod(g.BuildPodConfig, secrets, build.Spec.Builder, userAndGroup)
+	return build.BuildPod(g.BuildPodConfig, secrets, build.Spec.Builder, builderConfig)
+}
+
+// GenerateForPlatform generates the pod building one platform of a
+// multi-platform build with the builder and run image of that platform.
+func (g *Generator) GenerateForPlatform(build *v1alpha1.Build, platform string) (*v1.Pod, error) {
+	secrets, err := g.fetchBuildSecrets(build)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := build.Spec.Builder
+	builder.Image, err = g.PlatformResolver.Resolve(build.Spec.Builder.Image, platform, registry.SecretRef{
+		Namespace:        build.Namespace,
+		ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	platformBuild := build.PlatformBuild(platform)
+	platformBuild.Spec.Builder = builder
+	builderConfig, err := g.fetchBuilderConfig(platformBuild)
+	if err != nil {
+		return nil, err
+	}
+
+	builderConfig.RunImage, err = g.PlatformResolver.Resolve(builderConfig.RunImage, platform, registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccount,
+		Namespace:      build.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return build.PlatformBuildPod(platform, g.BuildPodConfig, secrets, builder, builderConfig)
 }
 
 func (g *Generator) fetchBuildSecrets(build *v1alpha1.Build) ([]corev1.Secret, error) {
@@ -51,31 +93,50 @@ func (g *Generator) fetchBuildSecrets(build *v1alpha1.Build) ([]corev1.Secret, e
 const cnbUserId = "CNB_USER_ID"
 const cnbGroupId = "CNB_GROUP_ID"
 
-func (g *Generator) fetchUserAndGroup(build *v1alpha1.Build) (v1alpha1.UserAndGroup, error) {
+func (g *Generator) fetchBuilderConfig(build *v1alpha1.Build) (v1alpha1.BuildPodBuilderConfig, error) {
 	image, err := g.RemoteImageFactory.NewRemote(build.Spec.Builder.Image, registry.SecretRef{
 		Namespace:        build.Namespace,
 		ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
 	})
 	if err != nil {
-		return v1alpha1.UserAndGroup{}, err
+		return v1alpha1.BuildPodBuilderConfig{}, err
 	}
 
 	uid, err := parseCNBID(image, cnbUserId)
 	if err != nil {
-		return v1alpha1.UserAndGroup{}, err
+		return v1alpha1.BuildPodBuilderConfig{}, err
 	}
 
 	gid, err := parseCNBID(image, cnbGroupId)
 	if err != nil {
-		return v1alpha1.UserAndGroup{}, err
+		return v1alpha1.BuildPodBuilderConfig{}, err
 	}
 
-	return v1alpha1.UserAndGroup{
-		Uid: uid,
-		Gid: gid,
+	runImage, err := runImageForBuild(build, image)
+	if err != nil {
+		return v1alpha1.BuildPodBuilderConfig{}, err
+	}
+
+	return v1alpha1.BuildPodBuilderConfig{
+		Uid:      uid,
+		Gid:      gid,
+		RunImage: runImage,
 	}, nil
 }
 
+func runImageForBuild(build *v1alpha1.Build, builderImage registry.RemoteImage) (string, error) {
+	if build.Spec.RunImage != "" {
+		return build.Spec.RunImage, nil
+	}
+
+	metadata, err := cnb.ReadBuilderMetadata(builderImage)
+	if err != nil {
+		return "", err
+	}
+
+	return cnb.RunImageForTag(metadata.Stack, build.Tag())
+}
+
 func parseCNBID(image registry.RemoteImage, env string) (int64, error) {
 	v, err := image.Env(env)
 	if err != nil {
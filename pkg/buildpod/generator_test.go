@@ -12,6 +12,7 @@ import (
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/buildpod"
+	"github.com/pivotal/kpack/pkg/buildpod/buildpodfakes"
 	"github.com/pivotal/kpack/pkg/registry"
 	"github.com/pivotal/kpack/pkg/registry/registryfakes"
 )
@@ -88,6 +89,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 			fakeImage := registryfakes.NewFakeRemoteImage("some/builder", "2bc85afc0ee0aec012b3889cf5f2e9690bb504c9d19ce90add2f415b85990895")
 			require.NoError(t, fakeImage.SetEnv("CNB_USER_ID", "1234"))
 			require.NoError(t, fakeImage.SetEnv("CNB_GROUP_ID", "5678"))
+			require.NoError(t, fakeImage.SetLabel("io.buildpacks.builder.metadata", `{"stack": {"runImage": {"image": "index.docker.io/some/run", "mirrors": ["gcr.io/some/run", "some.registry.io/some/run"]}}}`))
 
 			fakeRemoteImageFactory.NewRemoteReturns(fakeImage, nil)
 
@@ -107,8 +109,8 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				},
 				Spec: v1alpha1.BuildSpec{
 					Tags: []string{
-						"builderImage/name",
-						"additional/names",
+						"some.registry.io/some/image",
+						"some.registry.io/some/image:additional",
 					},
 					Builder:        builder.BuildBuilderSpec(),
 					ServiceAccount: serviceAccountName,
@@ -143,9 +145,10 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 			expectedPod, err := build.BuildPod(buildPodConfig, []corev1.Secret{
 				*gitSecret,
 				*dockerSecret,
-			}, builder.BuildBuilderSpec(), v1alpha1.UserAndGroup{
-				Uid: 1234,
-				Gid: 5678,
+			}, builder.BuildBuilderSpec(), v1alpha1.BuildPodBuilderConfig{
+				Uid:      1234,
+				Gid:      5678,
+				RunImage: "some.registry.io/some/run",
 			})
 			require.NoError(t, err)
 			require.Equal(t, expectedPod, pod)
@@ -159,5 +162,134 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				ImagePullSecrets: builder.BuildBuilderSpec().ImagePullSecrets,
 			}, secretRef)
 		})
+
+		it("uses the run image of the build instead of the builder run image", func() {
+			fakeRemoteImageFactory := &registryfakes.FakeRemoteImageFactory{}
+			fakeImage := registryfakes.NewFakeRemoteImage("some/builder", "2bc85afc0ee0aec012b3889cf5f2e9690bb504c9d19ce90add2f415b85990895")
+			require.NoError(t, fakeImage.SetEnv("CNB_USER_ID", "1234"))
+			require.NoError(t, fakeImage.SetEnv("CNB_GROUP_ID", "5678"))
+
+			fakeRemoteImageFactory.NewRemoteReturns(fakeImage, nil)
+
+			buildPodConfig := v1alpha1.BuildPodConfig{
+				BuildInitImage: "build/init:builderImage",
+				NopImage:       "no/op:builderImage",
+			}
+			generator := &buildpod.Generator{
+				BuildPodConfig:     buildPodConfig,
+				K8sClient:          fakeK8sClient,
+				RemoteImageFactory: fakeRemoteImageFactory,
+			}
+
+			build := &v1alpha1.Build{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "simple-build",
+				},
+				Spec: v1alpha1.BuildSpec{
+					Tags:           []string{"some.registry.io/some/image"},
+					Builder:        builder.BuildBuilderSpec(),
+					ServiceAccount: serviceAccountName,
+					Source: v1alpha1.SourceConfig{
+						Git: &v1alpha1.Git{
+							URL:      "http://www.google.com",
+							Revision: "master",
+						},
+					},
+					RunImage: "some.registry.io/other/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8",
+				},
+			}
+			pod, err := generator.Generate(build)
+			require.NoError(t, err)
+
+			expectedPod, err := build.BuildPod(buildPodConfig, []corev1.Secret{
+				*gitSecret,
+				*dockerSecret,
+			}, builder.BuildBuilderSpec(), v1alpha1.BuildPodBuilderConfig{
+				Uid:      1234,
+				Gid:      5678,
+				RunImage: "some.registry.io/other/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8",
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedPod, pod)
+		})
+
+		it("generates the pod of a platform with the builder and run image of the platform", func() {
+			fakeRemoteImageFactory := &registryfakes.FakeRemoteImageFactory{}
+			fakeImage := registryfakes.NewFakeRemoteImage("some/builder", "2bc85afc0ee0aec012b3889cf5f2e9690bb504c9d19ce90add2f415b85990895")
+			require.NoError(t, fakeImage.SetEnv("CNB_USER_ID", "1234"))
+			require.NoError(t, fakeImage.SetEnv("CNB_GROUP_ID", "5678"))
+			require.NoError(t, fakeImage.SetLabel("io.buildpacks.builder.metadata", `{"stack": {"runImage": {"image": "some.registry.io/some/run"}}}`))
+			fakeRemoteImageFactory.NewRemoteReturns(fakeImage, nil)
+
+			fakePlatformResolver := &buildpodfakes.FakePlatformResolver{}
+			fakePlatformResolver.ResolveReturnsOnCall(0, "some.registry.io/builder@sha256:arm64-builder", nil)
+			fakePlatformResolver.ResolveReturnsOnCall(1, "some.registry.io/some/run@sha256:arm64-run", nil)
+
+			buildPodConfig := v1alpha1.BuildPodConfig{
+				BuildInitImage: "build/init:builderImage",
+				NopImage:       "no/op:builderImage",
+			}
+			generator := &buildpod.Generator{
+				BuildPodConfig:     buildPodConfig,
+				K8sClient:          fakeK8sClient,
+				RemoteImageFactory: fakeRemoteImageFactory,
+				PlatformResolver:   fakePlatformResolver,
+			}
+
+			build := &v1alpha1.Build{
+				ObjectMeta: v1.ObjectMeta{
+					Name: "simple-build",
+				},
+				Spec: v1alpha1.BuildSpec{
+					Tags: []string{"some.registry.io/some/image"},
+					Builder: v1alpha1.BuildBuilderSpec{
+						Image:            "some.registry.io/builder@sha256:index",
+						ImagePullSecrets: []corev1.LocalObjectReference{{Name: "builder-secret"}},
+					},
+					ServiceAccount: serviceAccountName,
+					Source: v1alpha1.SourceConfig{
+						Git: &v1alpha1.Git{
+							URL:      "http://www.google.com",
+							Revision: "master",
+						},
+					},
+					Platforms: []string{"linux/amd64", "linux/arm64"},
+				},
+			}
+			pod, err := generator.GenerateForPlatform(build, "linux/arm64")
+			require.NoError(t, err)
+
+			expectedPod, err := build.PlatformBuildPod("linux/arm64", buildPodConfig, []corev1.Secret{
+				*gitSecret,
+				*dockerSecret,
+			}, v1alpha1.BuildBuilderSpec{
+				Image:            "some.registry.io/builder@sha256:arm64-builder",
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "builder-secret"}},
+			}, v1alpha1.BuildPodBuilderConfig{
+				Uid:      1234,
+				Gid:      5678,
+				RunImage: "some.registry.io/some/run@sha256:arm64-run",
+			})
+			require.NoError(t, err)
+			require.Equal(t, expectedPod, pod)
+
+			require.Equal(t, 2, fakePlatformResolver.ResolveCallCount())
+			image, platform, secretRef := fakePlatformResolver.ResolveArgsForCall(0)
+			require.Equal(t, "some.registry.io/builder@sha256:index", image)
+			require.Equal(t, "linux/arm64", platform)
+			require.Equal(t, registry.SecretRef{
+				ImagePullSecrets: []corev1.LocalObjectReference{{Name: "builder-secret"}},
+			}, secretRef)
+
+			image, platform, secretRef = fakePlatformResolver.ResolveArgsForCall(1)
+			require.Equal(t, "some.registry.io/some/run", image)
+			require.Equal(t, "linux/arm64", platform)
+			require.Equal(t, registry.SecretRef{
+				ServiceAccount: serviceAccountName,
+			}, secretRef)
+
+			builderImage, _ := fakeRemoteImageFactory.NewRemoteArgsForCall(0)
+			require.Equal(t, "some.registry.io/builder@sha256:arm64-builder", builderImage)
+		})
 	})
 }
@@ -20,6 +20,7 @@ package versioned
 
 import (
 	buildv1alpha1 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha1"
+	buildv1alpha2 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha2"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -28,6 +29,7 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	BuildV1alpha1() buildv1alpha1.BuildV1alpha1Interface
+	BuildV1alpha2() buildv1alpha2.BuildV1alpha2Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -35,6 +37,7 @@ type Interface interface {
 type Clientset struct {
 	*discovery.DiscoveryClient
 	buildV1alpha1 *buildv1alpha1.BuildV1alpha1Client
+	buildV1alpha2 *buildv1alpha2.BuildV1alpha2Client
 }
 
 // BuildV1alpha1 retrieves the BuildV1alpha1Client
@@ -42,6 +45,11 @@ func (c *Clientset) BuildV1alpha1() buildv1alpha1.BuildV1alpha1Interface {
 	return c.buildV1alpha1
 }
 
+// BuildV1alpha2 retrieves the BuildV1alpha2Client
+func (c *Clientset) BuildV1alpha2() buildv1alpha2.BuildV1alpha2Interface {
+	return c.buildV1alpha2
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -62,6 +70,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.buildV1alpha2, err = buildv1alpha2.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -75,6 +87,7 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.buildV1alpha1 = buildv1alpha1.NewForConfigOrDie(c)
+	cs.buildV1alpha2 = buildv1alpha2.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -84,6 +97,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.buildV1alpha1 = buildv1alpha1.New(c)
+	cs.buildV1alpha2 = buildv1alpha2.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -22,6 +22,8 @@ import (
 	clientset "github.com/pivotal/kpack/pkg/client/clientset/versioned"
 	buildv1alpha1 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha1"
 	fakebuildv1alpha1 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha1/fake"
+	buildv1alpha2 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha2"
+	fakebuildv1alpha2 "github.com/pivotal/kpack/pkg/client/clientset/versioned/typed/build/v1alpha2/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -80,3 +82,8 @@ var _ clientset.Interface = &Clientset{}
 func (c *Clientset) BuildV1alpha1() buildv1alpha1.BuildV1alpha1Interface {
 	return &fakebuildv1alpha1.FakeBuildV1alpha1{Fake: &c.Fake}
 }
+
+// BuildV1alpha2 retrieves the BuildV1alpha2Client
+func (c *Clientset) BuildV1alpha2() buildv1alpha2.BuildV1alpha2Interface {
+	return &fakebuildv1alpha2.FakeBuildV1alpha2{Fake: &c.Fake}
+}
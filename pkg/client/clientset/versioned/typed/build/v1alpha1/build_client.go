@@ -29,8 +29,12 @@ type BuildV1alpha1Interface interface {
 	BuildsGetter
 	BuildersGetter
 	ClusterBuildersGetter
+	CustomBuildersGetter
 	ImagesGetter
+	ImagePromotionsGetter
 	SourceResolversGetter
+	StacksGetter
+	StoresGetter
 }
 
 // BuildV1alpha1Client is used to interact with features provided by the build.pivotal.io group.
@@ -50,14 +54,30 @@ func (c *BuildV1alpha1Client) ClusterBuilders() ClusterBuilderInterface {
 	return newClusterBuilders(c)
 }
 
+func (c *BuildV1alpha1Client) CustomBuilders(namespace string) CustomBuilderInterface {
+	return newCustomBuilders(c, namespace)
+}
+
 func (c *BuildV1alpha1Client) Images(namespace string) ImageInterface {
 	return newImages(c, namespace)
 }
 
+func (c *BuildV1alpha1Client) ImagePromotions(namespace string) ImagePromotionInterface {
+	return newImagePromotions(c, namespace)
+}
+
 func (c *BuildV1alpha1Client) SourceResolvers(namespace string) SourceResolverInterface {
 	return newSourceResolvers(c, namespace)
 }
 
+func (c *BuildV1alpha1Client) Stacks() StackInterface {
+	return newStacks(c)
+}
+
+func (c *BuildV1alpha1Client) Stores() StoreInterface {
+	return newStores(c)
+}
+
 // NewForConfig creates a new BuildV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*BuildV1alpha1Client, error) {
 	config := *c
@@ -40,14 +40,30 @@ func (c *FakeBuildV1alpha1) ClusterBuilders() v1alpha1.ClusterBuilderInterface {
 	return &FakeClusterBuilders{c}
 }
 
+func (c *FakeBuildV1alpha1) CustomBuilders(namespace string) v1alpha1.CustomBuilderInterface {
+	return &FakeCustomBuilders{c, namespace}
+}
+
 func (c *FakeBuildV1alpha1) Images(namespace string) v1alpha1.ImageInterface {
 	return &FakeImages{c, namespace}
 }
 
+func (c *FakeBuildV1alpha1) ImagePromotions(namespace string) v1alpha1.ImagePromotionInterface {
+	return &FakeImagePromotions{c, namespace}
+}
+
 func (c *FakeBuildV1alpha1) SourceResolvers(namespace string) v1alpha1.SourceResolverInterface {
 	return &FakeSourceResolvers{c, namespace}
 }
 
+func (c *FakeBuildV1alpha1) Stacks() v1alpha1.StackInterface {
+	return &FakeStacks{c}
+}
+
+func (c *FakeBuildV1alpha1) Stores() v1alpha1.StoreInterface {
+	return &FakeStores{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBuildV1alpha1) RESTClient() rest.Interface {
@@ -24,6 +24,14 @@ type BuilderExpansion interface{}
 
 type ClusterBuilderExpansion interface{}
 
+type CustomBuilderExpansion interface{}
+
 type ImageExpansion interface{}
 
+type ImagePromotionExpansion interface{}
+
 type SourceResolverExpansion interface{}
+
+type StackExpansion interface{}
+
+type StoreExpansion interface{}
@@ -30,10 +30,18 @@ type Interface interface {
 	Builders() BuilderInformer
 	// ClusterBuilders returns a ClusterBuilderInformer.
 	ClusterBuilders() ClusterBuilderInformer
+	// CustomBuilders returns a CustomBuilderInformer.
+	CustomBuilders() CustomBuilderInformer
 	// Images returns a ImageInformer.
 	Images() ImageInformer
+	// ImagePromotions returns a ImagePromotionInformer.
+	ImagePromotions() ImagePromotionInformer
 	// SourceResolvers returns a SourceResolverInformer.
 	SourceResolvers() SourceResolverInformer
+	// Stacks returns a StackInformer.
+	Stacks() StackInformer
+	// Stores returns a StoreInformer.
+	Stores() StoreInformer
 }
 
 type version struct {
@@ -62,12 +70,32 @@ func (v *version) ClusterBuilders() ClusterBuilderInformer {
 	return &clusterBuilderInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// CustomBuilders returns a CustomBuilderInformer.
+func (v *version) CustomBuilders() CustomBuilderInformer {
+	return &customBuilderInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Images returns a ImageInformer.
 func (v *version) Images() ImageInformer {
 	return &imageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ImagePromotions returns a ImagePromotionInformer.
+func (v *version) ImagePromotions() ImagePromotionInformer {
+	return &imagePromotionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SourceResolvers returns a SourceResolverInformer.
 func (v *version) SourceResolvers() SourceResolverInformer {
 	return &sourceResolverInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Stacks returns a StackInformer.
+func (v *version) Stacks() StackInformer {
+	return &stackInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Stores returns a StoreInformer.
+func (v *version) Stores() StoreInformer {
+	return &storeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	v1alpha2 "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -59,10 +60,38 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().Builders().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("clusterbuilders"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().ClusterBuilders().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("custombuilders"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().CustomBuilders().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("images"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().Images().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("imagepromotions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().ImagePromotions().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("sourceresolvers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().SourceResolvers().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("stacks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().Stacks().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("stores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha1().Stores().Informer()}, nil
+
+		// Group=build.pivotal.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithResource("builds"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().Builds().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("builders"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().Builders().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("clusterbuilders"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().ClusterBuilders().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("custombuilders"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().CustomBuilders().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("images"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().Images().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("imagepromotions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().ImagePromotions().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("sourceresolvers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().SourceResolvers().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("stacks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().Stacks().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("stores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Build().V1alpha2().Stores().Informer()}, nil
 
 	}
 
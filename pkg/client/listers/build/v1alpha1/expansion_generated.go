@@ -38,6 +38,14 @@ type BuilderNamespaceListerExpansion interface{}
 // ClusterBuilderLister.
 type ClusterBuilderListerExpansion interface{}
 
+// CustomBuilderListerExpansion allows custom methods to be added to
+// CustomBuilderLister.
+type CustomBuilderListerExpansion interface{}
+
+// CustomBuilderNamespaceListerExpansion allows custom methods to be added to
+// CustomBuilderNamespaceLister.
+type CustomBuilderNamespaceListerExpansion interface{}
+
 // ImageListerExpansion allows custom methods to be added to
 // ImageLister.
 type ImageListerExpansion interface{}
@@ -46,6 +54,14 @@ type ImageListerExpansion interface{}
 // ImageNamespaceLister.
 type ImageNamespaceListerExpansion interface{}
 
+// ImagePromotionListerExpansion allows custom methods to be added to
+// ImagePromotionLister.
+type ImagePromotionListerExpansion interface{}
+
+// ImagePromotionNamespaceListerExpansion allows custom methods to be added to
+// ImagePromotionNamespaceLister.
+type ImagePromotionNamespaceListerExpansion interface{}
+
 // SourceResolverListerExpansion allows custom methods to be added to
 // SourceResolverLister.
 type SourceResolverListerExpansion interface{}
@@ -53,3 +69,11 @@ type SourceResolverListerExpansion interface{}
 // SourceResolverNamespaceListerExpansion allows custom methods to be added to
 // SourceResolverNamespaceLister.
 type SourceResolverNamespaceListerExpansion interface{}
+
+// StackListerExpansion allows custom methods to be added to
+// StackLister.
+type StackListerExpansion interface{}
+
+// StoreListerExpansion allows custom methods to be added to
+// StoreLister.
+type StoreListerExpansion interface{}
@@ -0,0 +1,65 @@
+/*
+ * Copyright 2019 The original author or authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1alpha2 "github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ClusterBuilderLister helps list ClusterBuilders.
+type ClusterBuilderLister interface {
+	// List lists all ClusterBuilders in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha2.ClusterBuilder, err error)
+	// Get retrieves the ClusterBuilder from the index for a given name.
+	Get(name string) (*v1alpha2.ClusterBuilder, error)
+	ClusterBuilderListerExpansion
+}
+
+// clusterBuilderLister implements the ClusterBuilderLister interface.
+type clusterBuilderLister struct {
+	indexer cache.Indexer
+}
+
+// NewClusterBuilderLister returns a new ClusterBuilderLister.
+func NewClusterBuilderLister(indexer cache.Indexer) ClusterBuilderLister {
+	return &clusterBuilderLister{indexer: indexer}
+}
+
+// List lists all ClusterBuilders in the indexer.
+func (s *clusterBuilderLister) List(selector labels.Selector) (ret []*v1alpha2.ClusterBuilder, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha2.ClusterBuilder))
+	})
+	return ret, err
+}
+
+// Get retrieves the ClusterBuilder from the index for a given name.
+func (s *clusterBuilderLister) Get(name string) (*v1alpha2.ClusterBuilder, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha2.Resource("clusterbuilder"), name)
+	}
+	return obj.(*v1alpha2.ClusterBuilder), nil
+}
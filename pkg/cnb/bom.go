@@ -0,0 +1,91 @@
+package cnb
+
+import (
+	"encoding/json"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type bomLabelEntry struct {
+	Name      string                 `json:"name"`
+	Version   string                 `json:"version"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	Buildpack v1alpha1.BOMBuildpack  `json:"buildpack"`
+}
+
+// readBOM reads the bill of materials from the build metadata label. Buildpacks
+// do not agree on a metadata format, so the checksum, uri, licenses and nested
+// dependencies are read from the keys used by most buildpacks. A bill of
+// materials that is not a list of entries is ignored.
+func readBOM(bom interface{}) []v1alpha1.BOMEntry {
+	if bom == nil {
+		return nil
+	}
+
+	buf, err := json.Marshal(bom)
+	if err != nil {
+		return nil
+	}
+
+	var labelEntries []bomLabelEntry
+	if err := json.Unmarshal(buf, &labelEntries); err != nil {
+		return nil
+	}
+
+	var entries []v1alpha1.BOMEntry
+	for _, e := range labelEntries {
+		entries = append(entries, bomEntry(e.Name, e.Version, e.Metadata, e.Buildpack))
+
+		dependencies, _ := e.Metadata["dependencies"].([]interface{})
+		for _, d := range dependencies {
+			dependency, ok := d.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			entries = append(entries, bomEntry(stringValue(dependency, "name"), "", dependency, e.Buildpack))
+		}
+	}
+	return entries
+}
+
+func bomEntry(name, version string, metadata map[string]interface{}, buildpack v1alpha1.BOMBuildpack) v1alpha1.BOMEntry {
+	if version == "" {
+		version = stringValue(metadata, "version")
+	}
+
+	return v1alpha1.BOMEntry{
+		Name:      name,
+		Version:   version,
+		Buildpack: buildpack,
+		SHA256:    stringValue(metadata, "sha256", "sha"),
+		URI:       stringValue(metadata, "uri", "url"),
+		Licenses:  licenses(metadata["licenses"]),
+	}
+}
+
+// licenses reads licenses listed as identifiers or as objects with a type.
+func licenses(value interface{}) []string {
+	list, _ := value.([]interface{})
+
+	var licenses []string
+	for _, l := range list {
+		switch license := l.(type) {
+		case string:
+			licenses = append(licenses, license)
+		case map[string]interface{}:
+			if id := stringValue(license, "type", "id", "name"); id != "" {
+				licenses = append(licenses, id)
+			}
+		}
+	}
+	return licenses
+}
+
+func stringValue(values map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := values[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
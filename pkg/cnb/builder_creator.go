@@ -0,0 +1,414 @@
+package cnb
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpack/lifecycle"
+	lcyclemd "github.com/buildpack/lifecycle/metadata"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	BuildpackLayersLabel = "io.buildpacks.buildpack.layers"
+	StackIDLabel         = "io.buildpacks.stack.id"
+
+	cnbDir    = "/cnb"
+	orderPath = "/cnb/order.toml"
+	stackPath = "/cnb/stack.toml"
+)
+
+// Layers written by kpack use a fixed timestamp so that assembling the same
+// builder twice produces the same digest.
+var normalizedTime = time.Date(1980, time.January, 1, 0, 0, 1, 0, time.UTC)
+
+type BuildpackLayerInfo struct {
+	LayerDiffID string           `json:"layerDiffID"`
+	API         string           `json:"api,omitempty"`
+	Stacks      []BuildpackStack `json:"stacks,omitempty"`
+}
+
+type BuildpackStack struct {
+	ID string `json:"id"`
+}
+
+// BuildpackLayerMetadata is the content of the io.buildpacks.buildpack.layers
+// label keyed by buildpack id and version.
+type BuildpackLayerMetadata map[string]map[string]BuildpackLayerInfo
+
+type RemoteBuilderCreator struct {
+	KeychainFactory registry.KeychainFactory
+	LifecycleImage  string
+}
+
+// Revisions resolves the build, lifecycle and store images a custom builder is
+// assembled from to digests so that it can be assembled again when one of them
+// moves.
+func (r *RemoteBuilderCreator) Revisions(customBuilder *v1alpha1.CustomBuilder) ([]string, error) {
+	keychain, err := r.keychain(customBuilder)
+	if err != nil {
+		return nil, err
+	}
+
+	images := []string{customBuilder.Spec.Stack.BuildImage, r.LifecycleImage}
+	for _, storeImage := range customBuilder.Spec.Store {
+		images = append(images, storeImage.Image)
+	}
+
+	revisions := make([]string, 0, len(images))
+	for _, image := range images {
+		ref, err := name.ParseReference(image, name.WeakValidation)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptor, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve %s", image)
+		}
+		revisions = append(revisions, ref.Context().Name()+"@"+descriptor.Digest.String())
+	}
+	return revisions, nil
+}
+
+func (r *RemoteBuilderCreator) CreateBuilder(customBuilder *v1alpha1.CustomBuilder) (string, error) {
+	keychain, err := r.keychain(customBuilder)
+	if err != nil {
+		return "", err
+	}
+
+	spec := customBuilder.Spec
+
+	buildImage, err := fetchImage(spec.Stack.BuildImage, keychain)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to fetch build image")
+	}
+
+	stackID, err := imageLabel(buildImage, StackIDLabel)
+	if err != nil {
+		return "", err
+	}
+	if stackID != spec.Stack.ID {
+		return "", errors.Errorf("build image stack %q does not match stack %q", stackID, spec.Stack.ID)
+	}
+
+	lifecycleImage, err := fetchImage(r.LifecycleImage, keychain)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to fetch lifecycle image")
+	}
+
+	lifecycleLayers, err := lifecycleImage.Layers()
+	if err != nil {
+		return "", err
+	}
+
+	store, err := newBuildpackStore(spec.Store, keychain)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := store.resolve(spec.Order, spec.Stack.ID)
+	if err != nil {
+		return "", err
+	}
+
+	configLayer, err := builderConfigLayer(resolved.order, spec.Stack.RunImage)
+	if err != nil {
+		return "", err
+	}
+
+	layers := append(append(lifecycleLayers, resolved.layers...), configLayer)
+	image, err := mutate.AppendLayers(buildImage, layers...)
+	if err != nil {
+		return "", err
+	}
+
+	image, err = withBuilderMetadata(image, BuilderImageMetadata{
+		Buildpacks: resolved.buildpacks,
+		Groups:     resolved.groups,
+		Stack: lcyclemd.StackMetadata{
+			RunImage: lcyclemd.StackRunImageMetadata{
+				Image: spec.Stack.RunImage,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := name.ParseReference(spec.Tag, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	err = remote.Write(ref, image, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to push builder to %s", spec.Tag)
+	}
+
+	digest, err := image.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Context().Name() + "@" + digest.String(), nil
+}
+
+func (r *RemoteBuilderCreator) keychain(customBuilder *v1alpha1.CustomBuilder) (authn.Keychain, error) {
+	if r.LifecycleImage == "" {
+		return nil, errors.New("lifecycle image is not configured")
+	}
+
+	return r.KeychainFactory.KeychainForSecretRef(registry.SecretRef{
+		ServiceAccount: customBuilder.Spec.ServiceAccount,
+		Namespace:      customBuilder.Namespace,
+	})
+}
+
+type buildpackStore map[string]map[string]storeBuildpack
+
+type storeBuildpack struct {
+	info  BuildpackLayerInfo
+	image v1.Image
+}
+
+func newBuildpackStore(storeImages []v1alpha1.StoreImage, keychain authn.Keychain) (buildpackStore, error) {
+	store := buildpackStore{}
+	for _, storeImage := range storeImages {
+		image, err := fetchImage(storeImage.Image, keychain)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to fetch store image %s", storeImage.Image)
+		}
+
+		layerMetadataJSON, err := imageLabel(image, BuildpackLayersLabel)
+		if err != nil {
+			return nil, err
+		}
+
+		var layerMetadata BuildpackLayerMetadata
+		if err := json.Unmarshal([]byte(layerMetadataJSON), &layerMetadata); err != nil {
+			return nil, errors.Wrapf(err, "unsupported buildpack layers metadata in %s", storeImage.Image)
+		}
+
+		for id, versions := range layerMetadata {
+			if _, ok := store[id]; !ok {
+				store[id] = map[string]storeBuildpack{}
+			}
+
+			for version, info := range versions {
+				store[id][version] = storeBuildpack{info: info, image: image}
+			}
+		}
+	}
+	return store, nil
+}
+
+type resolvedOrder struct {
+	order      lifecycle.BuildpackOrder
+	groups     []BuilderGroupMetadata
+	buildpacks []BuildpackMetadata
+	layers     []v1.Layer
+}
+
+func (s buildpackStore) resolve(order []v1alpha1.OrderEntry, stackID string) (resolvedOrder, error) {
+	var resolved resolvedOrder
+	added := map[BuildpackMetadata]bool{}
+
+	for _, entry := range order {
+		var group lifecycle.BuildpackGroup
+		var groupMetadata BuilderGroupMetadata
+
+		for _, ref := range entry.Group {
+			buildpack, version, err := s.find(ref)
+			if err != nil {
+				return resolvedOrder{}, err
+			}
+
+			if !buildpack.supportsStack(stackID) {
+				return resolvedOrder{}, errors.Errorf("buildpack %s@%s does not support stack %s", ref.ID, version, stackID)
+			}
+
+			metadata := BuildpackMetadata{ID: ref.ID, Version: version}
+			group.Group = append(group.Group, lifecycle.Buildpack{ID: ref.ID, Version: version, Optional: ref.Optional})
+			groupMetadata.Buildpacks = append(groupMetadata.Buildpacks, GroupBuildpackMetadata{ID: ref.ID, Version: version, Optional: ref.Optional})
+
+			if added[metadata] {
+				continue
+			}
+			added[metadata] = true
+
+			diffID, err := v1.NewHash(buildpack.info.LayerDiffID)
+			if err != nil {
+				return resolvedOrder{}, errors.Wrapf(err, "invalid layer diff id for buildpack %s@%s", ref.ID, version)
+			}
+
+			layer, err := buildpack.image.LayerByDiffID(diffID)
+			if err != nil {
+				return resolvedOrder{}, errors.Wrapf(err, "unable to find layer for buildpack %s@%s", ref.ID, version)
+			}
+
+			resolved.buildpacks = append(resolved.buildpacks, metadata)
+			resolved.layers = append(resolved.layers, layer)
+		}
+
+		resolved.order = append(resolved.order, group)
+		resolved.groups = append(resolved.groups, groupMetadata)
+	}
+
+	return resolved, nil
+}
+
+func (s buildpackStore) find(ref v1alpha1.BuildpackRef) (storeBuildpack, string, error) {
+	versions, ok := s[ref.ID]
+	if !ok {
+		return storeBuildpack{}, "", errors.Errorf("buildpack %s not found in store", ref.ID)
+	}
+
+	if ref.Version != "" {
+		buildpack, ok := versions[ref.Version]
+		if !ok {
+			return storeBuildpack{}, "", errors.Errorf("buildpack %s@%s not found in store", ref.ID, ref.Version)
+		}
+		return buildpack, ref.Version, nil
+	}
+
+	if len(versions) > 1 {
+		return storeBuildpack{}, "", errors.Errorf("buildpack %s has multiple versions in store, a version must be specified", ref.ID)
+	}
+
+	for version, buildpack := range versions {
+		return buildpack, version, nil
+	}
+	return storeBuildpack{}, "", errors.Errorf("buildpack %s not found in store", ref.ID)
+}
+
+func (b storeBuildpack) supportsStack(stackID string) bool {
+	if len(b.info.Stacks) == 0 {
+		return true
+	}
+
+	for _, stack := range b.info.Stacks {
+		if stack.ID == stackID {
+			return true
+		}
+	}
+	return false
+}
+
+func builderConfigLayer(order lifecycle.BuildpackOrder, runImage string) (v1.Layer, error) {
+	orderTOML := &bytes.Buffer{}
+	err := toml.NewEncoder(orderTOML).Encode(struct {
+		Order lifecycle.BuildpackOrder `toml:"order"`
+	}{order})
+	if err != nil {
+		return nil, err
+	}
+
+	stackTOML := &bytes.Buffer{}
+	err = toml.NewEncoder(stackTOML).Encode(lcyclemd.StackMetadata{
+		RunImage: lcyclemd.StackRunImageMetadata{Image: runImage},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	layerTar := &bytes.Buffer{}
+	tw := tar.NewWriter(layerTar)
+
+	err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     cnbDir,
+		Mode:     0755,
+		ModTime:  normalizedTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range []struct {
+		path    string
+		content []byte
+	}{
+		{orderPath, orderTOML.Bytes()},
+		{stackPath, stackTOML.Bytes()},
+	} {
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     file.path,
+			Mode:     0644,
+			Size:     int64(len(file.content)),
+			ModTime:  normalizedTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tw.Write(file.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(layerTar.Bytes())), nil
+	})
+}
+
+func withBuilderMetadata(image v1.Image, metadata BuilderImageMetadata) (v1.Image, error) {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	configFile, err := image.ConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	config := *configFile.Config.DeepCopy()
+	if config.Labels == nil {
+		config.Labels = map[string]string{}
+	}
+	config.Labels[BuilderMetadataLabel] = string(metadataJSON)
+
+	return mutate.Config(image, config)
+}
+
+func fetchImage(image string, keychain authn.Keychain) (v1.Image, error) {
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	return remote.Image(ref, remote.WithAuthFromKeychain(keychain))
+}
+
+func imageLabel(image v1.Image, label string) (string, error) {
+	configFile, err := image.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := configFile.Config.Labels[label]
+	if !ok {
+		return "", errors.Errorf("image is missing label %s", label)
+	}
+	return value, nil
+}
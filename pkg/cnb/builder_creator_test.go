@@ -0,0 +1,370 @@
+package cnb_test
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestRemoteBuilderCreator(t *testing.T) {
+	spec.Run(t, "Remote Builder Creator", testRemoteBuilderCreator)
+}
+
+func testRemoteBuilderCreator(t *testing.T, when spec.G, it spec.S) {
+	const stackID = "io.buildpacks.stacks.bionic"
+
+	var (
+		server          *httptest.Server
+		host            string
+		keychainFactory *fakeKeychainFactory
+		subject         *cnb.RemoteBuilderCreator
+		customBuilder   *v1alpha1.CustomBuilder
+		buildImage      v1.Image
+		lifecycleImage  v1.Image
+		storeImage      v1.Image
+		buildpackLayers = map[string]v1.Layer{}
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewServer(ggcrregistry.New())
+		host = strings.TrimPrefix(server.URL, "http://")
+
+		var err error
+		buildImage, err = random.Image(10, 2)
+		require.NoError(t, err)
+		buildImage, err = mutate.Config(buildImage, v1.Config{
+			Labels: map[string]string{cnb.StackIDLabel: stackID},
+			Env:    []string{"CNB_USER_ID=1000"},
+		})
+		require.NoError(t, err)
+		push(t, host+"/build:latest", buildImage)
+
+		lifecycleImage, err = random.Image(10, 1)
+		require.NoError(t, err)
+		push(t, host+"/lifecycle:latest", lifecycleImage)
+
+		layerMetadata := cnb.BuildpackLayerMetadata{}
+		var layers []v1.Layer
+		for _, bp := range []struct {
+			id, version string
+			stacks      []cnb.BuildpackStack
+		}{
+			{"org.one", "1.0.0", []cnb.BuildpackStack{{ID: stackID}}},
+			{"org.two", "2.0.0", []cnb.BuildpackStack{{ID: stackID}}},
+			{"org.multi", "1.0.0", nil},
+			{"org.multi", "2.0.0", nil},
+			{"org.other-stack", "1.0.0", []cnb.BuildpackStack{{ID: "some.other.stack"}}},
+		} {
+			layer, err := random.Layer(10, types.DockerLayer)
+			require.NoError(t, err)
+			diffID, err := layer.DiffID()
+			require.NoError(t, err)
+
+			if _, ok := layerMetadata[bp.id]; !ok {
+				layerMetadata[bp.id] = map[string]cnb.BuildpackLayerInfo{}
+			}
+			layerMetadata[bp.id][bp.version] = cnb.BuildpackLayerInfo{
+				LayerDiffID: diffID.String(),
+				API:         "0.2",
+				Stacks:      bp.stacks,
+			}
+			buildpackLayers[bp.id+"@"+bp.version] = layer
+			layers = append(layers, layer)
+		}
+
+		layerMetadataJSON, err := json.Marshal(layerMetadata)
+		require.NoError(t, err)
+
+		storeImage, err = mutate.AppendLayers(empty.Image, layers...)
+		require.NoError(t, err)
+		storeImage, err = mutate.Config(storeImage, v1.Config{
+			Labels: map[string]string{cnb.BuildpackLayersLabel: string(layerMetadataJSON)},
+		})
+		require.NoError(t, err)
+		push(t, host+"/store:latest", storeImage)
+
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &cnb.RemoteBuilderCreator{
+			KeychainFactory: keychainFactory,
+			LifecycleImage:  host + "/lifecycle:latest",
+		}
+
+		customBuilder = &v1alpha1.CustomBuilder{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "custom-builder",
+				Namespace: "some-namespace",
+			},
+			Spec: v1alpha1.CustomBuilderSpec{
+				Tag: host + "/custom-builder",
+				Stack: v1alpha1.CustomStack{
+					ID:         stackID,
+					BuildImage: host + "/build:latest",
+					RunImage:   "some.registry/run:latest",
+				},
+				Store: []v1alpha1.StoreImage{
+					{Image: host + "/store:latest"},
+				},
+				Order: []v1alpha1.OrderEntry{
+					{
+						Group: []v1alpha1.BuildpackRef{
+							{ID: "org.one", Version: "1.0.0"},
+							{ID: "org.two", Optional: true},
+						},
+					},
+					{
+						Group: []v1alpha1.BuildpackRef{
+							{ID: "org.two"},
+						},
+					},
+				},
+				ServiceAccount: "some-sa",
+			},
+		}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	when("#Revisions", func() {
+		it("resolves the build, lifecycle and store images to digests", func() {
+			revisions, err := subject.Revisions(customBuilder)
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{
+				host + "/build@" + digest(t, buildImage),
+				host + "/lifecycle@" + digest(t, lifecycleImage),
+				host + "/store@" + digest(t, storeImage),
+			}, revisions)
+			assert.Equal(t, registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}, keychainFactory.secretRef)
+		})
+
+		it("changes when a store image moves", func() {
+			before, err := subject.Revisions(customBuilder)
+			require.NoError(t, err)
+
+			movedStoreImage, err := mutate.Config(storeImage, v1.Config{
+				Labels: map[string]string{cnb.BuildpackLayersLabel: "{}"},
+			})
+			require.NoError(t, err)
+			push(t, host+"/store:latest", movedStoreImage)
+
+			after, err := subject.Revisions(customBuilder)
+			require.NoError(t, err)
+
+			assert.NotEqual(t, before, after)
+			assert.Equal(t, host+"/store@"+digest(t, movedStoreImage), after[2])
+		})
+
+		it("errors when the lifecycle image is not configured", func() {
+			subject.LifecycleImage = ""
+
+			_, err := subject.Revisions(customBuilder)
+			assert.EqualError(t, err, "lifecycle image is not configured")
+		})
+	})
+
+	when("#CreateBuilder", func() {
+		it("pushes a builder image assembled from the stack, lifecycle and store buildpacks", func() {
+			identifier, err := subject.CreateBuilder(customBuilder)
+			require.NoError(t, err)
+
+			require.True(t, strings.HasPrefix(identifier, host+"/custom-builder@sha256:"), identifier)
+			assert.Equal(t, registry.SecretRef{
+				ServiceAccount: "some-sa",
+				Namespace:      "some-namespace",
+			}, keychainFactory.secretRef)
+
+			builderImage := pull(t, identifier)
+
+			configFile, err := builderImage.ConfigFile()
+			require.NoError(t, err)
+			assert.Equal(t, stackID, configFile.Config.Labels[cnb.StackIDLabel])
+			assert.Equal(t, []string{"CNB_USER_ID=1000"}, configFile.Config.Env)
+
+			var metadata cnb.BuilderImageMetadata
+			require.NoError(t, json.Unmarshal([]byte(configFile.Config.Labels[cnb.BuilderMetadataLabel]), &metadata))
+			assert.Equal(t, []cnb.BuildpackMetadata{
+				{ID: "org.one", Version: "1.0.0"},
+				{ID: "org.two", Version: "2.0.0"},
+			}, metadata.Buildpacks)
+			assert.Equal(t, []cnb.BuilderGroupMetadata{
+				{Buildpacks: []cnb.GroupBuildpackMetadata{{ID: "org.one", Version: "1.0.0"}, {ID: "org.two", Version: "2.0.0", Optional: true}}},
+				{Buildpacks: []cnb.GroupBuildpackMetadata{{ID: "org.two", Version: "2.0.0"}}},
+			}, metadata.Groups)
+			assert.Equal(t, "some.registry/run:latest", metadata.Stack.RunImage.Image)
+
+			layers, err := builderImage.Layers()
+			require.NoError(t, err)
+			require.Len(t, layers, 2+1+2+1)
+
+			assertSameLayer(t, lifecycleImage, 0, layers[2])
+			assertLayerDiffID(t, buildpackLayers["org.one@1.0.0"], layers[3])
+			assertLayerDiffID(t, buildpackLayers["org.two@2.0.0"], layers[4])
+
+			files := layerFiles(t, layers[5])
+			assert.Equal(t, `[[order]]
+
+  [[order.group]]
+    id = "org.one"
+    version = "1.0.0"
+
+  [[order.group]]
+    id = "org.two"
+    version = "2.0.0"
+    optional = true
+
+[[order]]
+
+  [[order.group]]
+    id = "org.two"
+    version = "2.0.0"
+`, files["/cnb/order.toml"])
+			assert.Equal(t, `[run-image]
+  image = "some.registry/run:latest"
+`, files["/cnb/stack.toml"])
+		})
+
+		it("produces the same digest when nothing changed", func() {
+			first, err := subject.CreateBuilder(customBuilder)
+			require.NoError(t, err)
+
+			second, err := subject.CreateBuilder(customBuilder)
+			require.NoError(t, err)
+
+			assert.Equal(t, first, second)
+		})
+
+		it("errors when a buildpack is not in the store", func() {
+			customBuilder.Spec.Order[0].Group[0].ID = "org.missing"
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, "buildpack org.missing not found in store")
+		})
+
+		it("errors when a buildpack version is not in the store", func() {
+			customBuilder.Spec.Order[0].Group[0].Version = "9.9.9"
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, "buildpack org.one@9.9.9 not found in store")
+		})
+
+		it("errors when a buildpack version is ambiguous", func() {
+			customBuilder.Spec.Order[0].Group[0] = v1alpha1.BuildpackRef{ID: "org.multi"}
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, "buildpack org.multi has multiple versions in store, a version must be specified")
+		})
+
+		it("errors when a buildpack does not support the stack", func() {
+			customBuilder.Spec.Order[0].Group[0] = v1alpha1.BuildpackRef{ID: "org.other-stack"}
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, fmt.Sprintf("buildpack org.other-stack@1.0.0 does not support stack %s", stackID))
+		})
+
+		it("errors when the build image does not match the stack", func() {
+			customBuilder.Spec.Stack.ID = "some.other.stack"
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, fmt.Sprintf(`build image stack "%s" does not match stack "some.other.stack"`, stackID))
+		})
+
+		it("errors when the lifecycle image is not configured", func() {
+			subject.LifecycleImage = ""
+
+			_, err := subject.CreateBuilder(customBuilder)
+			assert.EqualError(t, err, "lifecycle image is not configured")
+		})
+	})
+}
+
+type fakeKeychainFactory struct {
+	secretRef registry.SecretRef
+}
+
+func (f *fakeKeychainFactory) KeychainForSecretRef(ref registry.SecretRef) (authn.Keychain, error) {
+	f.secretRef = ref
+	return authn.DefaultKeychain, nil
+}
+
+func push(t *testing.T, tag string, image v1.Image) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, image, remote.WithAuthFromKeychain(authn.DefaultKeychain)))
+}
+
+func digest(t *testing.T, image v1.Image) string {
+	d, err := image.Digest()
+	require.NoError(t, err)
+	return d.String()
+}
+
+func pull(t *testing.T, reference string) v1.Image {
+	ref, err := name.ParseReference(reference, name.WeakValidation)
+	require.NoError(t, err)
+	image, err := remote.Image(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	require.NoError(t, err)
+	return image
+}
+
+func assertSameLayer(t *testing.T, image v1.Image, index int, actual v1.Layer) {
+	layers, err := image.Layers()
+	require.NoError(t, err)
+	assertLayerDiffID(t, layers[index], actual)
+}
+
+func assertLayerDiffID(t *testing.T, expected, actual v1.Layer) {
+	expectedDiffID, err := expected.DiffID()
+	require.NoError(t, err)
+	actualDiffID, err := actual.DiffID()
+	require.NoError(t, err)
+	assert.Equal(t, expectedDiffID, actualDiffID)
+}
+
+func layerFiles(t *testing.T, layer v1.Layer) map[string]string {
+	reader, err := layer.Uncompressed()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	files := map[string]string{}
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+	return files
+}
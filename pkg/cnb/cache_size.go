@@ -0,0 +1,50 @@
+package cnb
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// CacheDirSize is the size of the files in a volume build cache.
+func CacheDirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// CacheImageSize is the size of the compressed layers of a registry build cache.
+func CacheImageSize(tag string, keychain authn.Keychain) (int64, error) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return 0, err
+	}
+
+	image, err := remote.Image(ref, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return 0, err
+	}
+
+	manifest, err := image.Manifest()
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, layer := range manifest.Layers {
+		size += layer.Size
+	}
+	return size, nil
+}
@@ -0,0 +1,81 @@
+package cnb_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/cnb"
+)
+
+func TestCacheSize(t *testing.T) {
+	spec.Run(t, "Cache Size", testCacheSize)
+}
+
+func testCacheSize(t *testing.T, when spec.G, it spec.S) {
+	when("#CacheDirSize", func() {
+		var cacheDir string
+
+		it.Before(func() {
+			var err error
+			cacheDir, err = ioutil.TempDir("", "cache-size")
+			require.NoError(t, err)
+		})
+
+		it.After(func() {
+			os.RemoveAll(cacheDir)
+		})
+
+		it("sums the size of all files in the cache", func() {
+			require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, "committed", "layer"), os.ModePerm))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "committed", "io.buildpacks.cache.tar"), make([]byte, 100), os.ModePerm))
+			require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "committed", "layer", "sha256:abc.tar"), make([]byte, 23), os.ModePerm))
+
+			size, err := cnb.CacheDirSize(cacheDir)
+			require.NoError(t, err)
+
+			assert.Equal(t, int64(123), size)
+		})
+
+		it("returns an error when the cache does not exist", func() {
+			_, err := cnb.CacheDirSize(filepath.Join(cacheDir, "missing"))
+			require.Error(t, err)
+		})
+	})
+
+	when("#CacheImageSize", func() {
+		it("sums the size of the layers of the cache image", func() {
+			server := httptest.NewServer(ggcrregistry.New())
+			defer server.Close()
+			tag := strings.TrimPrefix(server.URL, "http://") + "/app:cache"
+
+			cacheImage, err := random.Image(10, 3)
+			require.NoError(t, err)
+			push(t, tag, cacheImage)
+
+			layers, err := cacheImage.Layers()
+			require.NoError(t, err)
+			var expected int64
+			for _, layer := range layers {
+				layerSize, err := layer.Size()
+				require.NoError(t, err)
+				expected += layerSize
+			}
+
+			size, err := cnb.CacheImageSize(tag, authn.DefaultKeychain)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, size)
+		})
+	})
+}
@@ -15,46 +15,91 @@ import (
 const BuilderMetadataLabel = "io.buildpacks.builder.metadata"
 
 type BuildpackMetadata struct {
-	ID      string `json:"id"`
-	Version string `json:"version"`
+	ID       string `json:"id"`
+	Version  string `json:"version"`
+	Homepage string `json:"homepage,omitempty"`
 }
 
 type BuilderImageMetadata struct {
 	Buildpacks []BuildpackMetadata    `json:"buildpacks"`
+	Groups     []BuilderGroupMetadata `json:"groups,omitempty"`
 	Stack      lcyclemd.StackMetadata `json:"stack"`
+	Lifecycle  LifecycleMetadata      `json:"lifecycle,omitempty"`
+}
+
+type BuilderGroupMetadata struct {
+	Buildpacks []GroupBuildpackMetadata `json:"buildpacks"`
+}
+
+type GroupBuildpackMetadata struct {
+	ID       string `json:"id"`
+	Version  string `json:"version"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+type LifecycleMetadata struct {
+	Version string `json:"version,omitempty"`
 }
 
 type BuilderImage struct {
 	BuilderBuildpackMetadata BuilderMetadata
+	Order                    []v1alpha1.OrderEntry
+	StackID                  string
+	LifecycleVersion         string
 	RunImage                 string
 	Identifier               string
 }
 
 type BuilderMetadata []BuildpackMetadata
 
+type labeledImage interface {
+	Label(labelName string) (string, error)
+}
+
+func ReadBuilderMetadata(img labeledImage) (BuilderImageMetadata, error) {
+	metadataJSON, err := img.Label(BuilderMetadataLabel)
+	if err != nil {
+		return BuilderImageMetadata{}, errors.Wrap(err, "builder image metadata label not present")
+	}
+
+	var metadata BuilderImageMetadata
+	err = json.Unmarshal([]byte(metadataJSON), &metadata)
+	if err != nil {
+		return BuilderImageMetadata{}, errors.Wrap(err, "unsupported builder metadata structure")
+	}
+	return metadata, nil
+}
+
+// RunImageForTag selects the run image, or the mirror of it, hosted in the same
+// registry as tag so that exports and rebases do not pull across registries.
+func RunImageForTag(stack lcyclemd.StackMetadata, tag string) (string, error) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	return stack.BestRunImageMirror(ref.Context().RegistryStr())
+}
+
 type RemoteMetadataRetriever struct {
 	RemoteImageFactory registry.RemoteImageFactory
 }
 
 func (r *RemoteMetadataRetriever) GetBuilderImage(builder v1alpha1.BuilderResource) (BuilderImage, error) {
-	img, err := r.RemoteImageFactory.NewRemote(builder.Image(), registry.SecretRef{
+	secretRef := registry.SecretRef{
+		ServiceAccount:   builder.ServiceAccount(),
 		Namespace:        builder.GetObjectMeta().GetNamespace(),
 		ImagePullSecrets: builder.ImagePullSecrets(),
-	})
-	if err != nil {
-		return BuilderImage{}, errors.Wrap(err, "unable to fetch remote builder image")
 	}
 
-	var metadataJSON string
-	metadataJSON, err = img.Label(BuilderMetadataLabel)
+	img, err := r.RemoteImageFactory.NewRemote(builder.Image(), secretRef)
 	if err != nil {
-		return BuilderImage{}, errors.Wrap(err, "builder image metadata label not present")
+		return BuilderImage{}, errors.Wrap(err, "unable to fetch remote builder image")
 	}
 
-	var metadata BuilderImageMetadata
-	err = json.Unmarshal([]byte(metadataJSON), &metadata)
+	metadata, err := ReadBuilderMetadata(img)
 	if err != nil {
-		return BuilderImage{}, errors.Wrap(err, "unsupported builder metadata structure")
+		return BuilderImage{}, err
 	}
 
 	identifier, err := img.Identifier()
@@ -62,11 +107,12 @@ func (r *RemoteMetadataRetriever) GetBuilderImage(builder v1alpha1.BuilderResour
 		return BuilderImage{}, errors.Wrap(err, "failed to retrieve builder image SHA")
 	}
 
-	runImage, err := r.RemoteImageFactory.NewRemote(metadata.Stack.RunImage.Image, registry.SecretRef{
-		Namespace:        builder.GetObjectMeta().GetNamespace(),
-		ImagePullSecrets: builder.ImagePullSecrets(),
-	})
+	stackID, err := img.Label(StackIDLabel)
+	if err != nil {
+		return BuilderImage{}, err
+	}
 
+	runImage, err := r.RemoteImageFactory.NewRemote(metadata.Stack.RunImage.Image, secretRef)
 	if err != nil {
 		return BuilderImage{}, errors.Wrap(err, "unable to fetch remote run image")
 	}
@@ -78,21 +124,54 @@ func (r *RemoteMetadataRetriever) GetBuilderImage(builder v1alpha1.BuilderResour
 
 	return BuilderImage{
 		BuilderBuildpackMetadata: metadata.Buildpacks,
+		Order:                    transformGroups(metadata.Groups),
+		StackID:                  stackID,
+		LifecycleVersion:         metadata.Lifecycle.Version,
 		RunImage:                 runImageIdentifier,
 		Identifier:               identifier,
 	}, nil
 }
 
+func transformGroups(groups []BuilderGroupMetadata) []v1alpha1.OrderEntry {
+	var order []v1alpha1.OrderEntry
+	for _, group := range groups {
+		entry := v1alpha1.OrderEntry{}
+		for _, bp := range group.Buildpacks {
+			entry.Group = append(entry.Group, v1alpha1.BuildpackRef{
+				ID:       bp.ID,
+				Version:  bp.Version,
+				Optional: bp.Optional,
+			})
+		}
+		order = append(order, entry)
+	}
+	return order
+}
+
 func (r *RemoteMetadataRetriever) GetBuiltImage(ref *v1alpha1.Build) (BuiltImage, error) {
-	img, err := r.RemoteImageFactory.NewRemote(ref.Tag(), registry.SecretRef{
+	secretRef := registry.SecretRef{
 		ServiceAccount: ref.Spec.ServiceAccount,
 		Namespace:      ref.Namespace,
-	})
+	}
+
+	img, err := r.RemoteImageFactory.NewRemote(ref.Tag(), secretRef)
 	if err != nil {
 		return BuiltImage{}, err
 	}
 
-	return readBuiltImage(img)
+	builtImage, err := readBuiltImage(ref, img)
+	if err != nil {
+		return BuiltImage{}, err
+	}
+
+	builtImage.Tags, err = pushedTags(ref, builtImage.Identifier, func(tag string) (string, error) {
+		tagImage, err := r.RemoteImageFactory.NewRemote(tag, secretRef)
+		if err != nil {
+			return "", err
+		}
+		return tagImage.Identifier()
+	})
+	return builtImage, err
 }
 
 type BuiltImage struct {
@@ -100,9 +179,14 @@ type BuiltImage struct {
 	CompletedAt       time.Time
 	BuildpackMetadata []lcyclemd.BuildpackMetadata
 	RunImage          string
+	Tags              []v1alpha1.PushedTag
+	BOM               []v1alpha1.BOMEntry
 }
 
-func readBuiltImage(img registry.RemoteImage) (BuiltImage, error) {
+// readBuiltImage reads the metadata of an image built or rebased for build.
+// The run image is reported in the repository of the run image selected for
+// the build, or in the run image mirror matching the built image otherwise.
+func readBuiltImage(build *v1alpha1.Build, img registry.RemoteImage) (BuiltImage, error) {
 	var buildMetadataJSON string
 	var layerMetadataJSON string
 
@@ -142,7 +226,14 @@ func readBuiltImage(img registry.RemoteImage) (BuiltImage, error) {
 		return BuiltImage{}, err
 	}
 
-	baseRunImage := layerMetadata.Stack.RunImage.Image
+	baseRunImage := build.Spec.RunImage
+	if baseRunImage == "" {
+		baseRunImage, err = RunImageForTag(layerMetadata.Stack, identifier)
+		if err != nil {
+			return BuiltImage{}, err
+		}
+	}
+
 	baseImageRef, err := name.ParseReference(baseRunImage)
 	if err != nil {
 		return BuiltImage{}, err
@@ -153,5 +244,6 @@ func readBuiltImage(img registry.RemoteImage) (BuiltImage, error) {
 		CompletedAt:       imageCreatedAt,
 		BuildpackMetadata: buildMetadata.Buildpacks,
 		RunImage:          baseImageRef.Context().String() + "@" + runImageRef.Identifier(),
+		BOM:               readBOM(buildMetadata.BOM),
 	}, nil
 }
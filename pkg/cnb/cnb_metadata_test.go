@@ -71,6 +71,36 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 				assert.Equal(t, "index.docker.io/builder/image@sha256:2bc85afc0ee0aec012b3889cf5f2e9690bb504c9d19ce90add2f415b85990895", builderImage.Identifier)
 				assert.Equal(t, "foo.io/run@sha256:c9d19ce90add2f415b859908952bc85afc0ee0aec012b3889cf5f2e9690bb504", builderImage.RunImage)
 			})
+
+			it("gets the order, stack, lifecycle version and buildpack homepages", func() {
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/builder/image", "sha256:2bc85afc0ee0aec012b3889cf5f2e9690bb504c9d19ce90add2f415b85990895")
+				fakeRunImage := registryfakes.NewFakeRemoteImage("foo.io/run", "sha256:c9d19ce90add2f415b859908952bc85afc0ee0aec012b3889cf5f2e9690bb504")
+				require.NoError(t, fakeImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+				require.NoError(t, fakeImage.SetLabel("io.buildpacks.builder.metadata", `{
+  "buildpacks": [{"id": "test.id", "version": "1.2.3", "homepage": "https://test.example.com"}, {"id": "other.id", "version": "4.5.6"}],
+  "groups": [{"buildpacks": [{"id": "test.id", "version": "1.2.3"}, {"id": "other.id", "version": "4.5.6", "optional": true}]}, {"buildpacks": [{"id": "other.id", "version": "4.5.6"}]}],
+  "stack": {"runImage": {"image": "foo.io/run:basecnb"}},
+  "lifecycle": {"version": "0.4.0"}
+}`))
+
+				mockFactory.NewRemoteReturnsOnCall(0, fakeImage, nil)
+				mockFactory.NewRemoteReturnsOnCall(1, fakeRunImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+				builderImage, err := subject.GetBuilderImage(builder)
+				require.NoError(t, err)
+
+				assert.Equal(t, cnb.BuilderMetadata{
+					{ID: "test.id", Version: "1.2.3", Homepage: "https://test.example.com"},
+					{ID: "other.id", Version: "4.5.6"},
+				}, builderImage.BuilderBuildpackMetadata)
+				assert.Equal(t, []v1alpha1.OrderEntry{
+					{Group: []v1alpha1.BuildpackRef{{ID: "test.id", Version: "1.2.3"}, {ID: "other.id", Version: "4.5.6", Optional: true}}},
+					{Group: []v1alpha1.BuildpackRef{{ID: "other.id", Version: "4.5.6"}}},
+				}, builderImage.Order)
+				assert.Equal(t, "io.buildpacks.stacks.bionic", builderImage.StackID)
+				assert.Equal(t, "0.4.0", builderImage.LifecycleVersion)
+			})
 		})
 
 		when("GetBuiltImage", func() {
@@ -117,6 +147,182 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 					Namespace:      "namespace-name",
 				}, secretRef)
 			})
+
+			it("records the run image mirror in the registry of the built image", func() {
+				fakeImage := registryfakes.NewFakeRemoteImage("some.registry.io/built/image", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				err := fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`)
+				assert.NoError(t, err)
+				err = fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"some.registry.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb","mirrors":["some.registry.io/run:full-cnb"]}}}`)
+				assert.NoError(t, err)
+
+				mockFactory.NewRemoteReturns(fakeImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				result, err := subject.GetBuiltImage(build)
+				assert.NoError(t, err)
+
+				assert.Equal(t, "some.registry.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", result.RunImage)
+			})
+
+			it("records the run image in the repository of the run image selected for the build", func() {
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/built/image", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				err := fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`)
+				assert.NoError(t, err)
+				err = fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"some.registry.io/custom-run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`)
+				assert.NoError(t, err)
+
+				mockFactory.NewRemoteReturns(fakeImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				customRunImageBuild := build.DeepCopy()
+				customRunImageBuild.Spec.RunImage = "some.registry.io/custom-run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"
+
+				result, err := subject.GetBuiltImage(customRunImageBuild)
+				assert.NoError(t, err)
+
+				assert.Equal(t, "some.registry.io/custom-run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", result.RunImage)
+			})
+
+			it("reads the bill of materials of the built image", func() {
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/built/image", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.build.metadata", `{
+  "buildpacks": [{"id": "test.id", "version": "1.2.3"}],
+  "bom": [
+    {
+      "name": "openjdk-jre",
+      "version": "11.0.5",
+      "metadata": {
+        "sha256": "2b8bd3e9a6b1e1c8c0a0bd7f7e5f7b0c1d4f2f8b2d3b8f6c9a1e0d4b7c6a5f3e",
+        "uri": "https://github.com/AdoptOpenJDK/openjdk11-binaries/releases/download/OpenJDK11U-jre_x64_linux_hotspot_11.0.5_10.tar.gz",
+        "licenses": [{"type": "GPL-2.0 WITH Classpath-exception-2.0"}]
+      },
+      "buildpack": {"id": "org.cloudfoundry.openjdk", "version": "1.0.0"}
+    },
+    {
+      "name": "node_modules",
+      "metadata": {
+        "dependencies": [
+          {"name": "express", "version": "4.17.1", "licenses": ["MIT"]},
+          "not-a-dependency"
+        ]
+      },
+      "buildpack": {"id": "org.cloudfoundry.npm", "version": "0.0.2"}
+    }
+  ]
+}`))
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"gcr.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`))
+
+				mockFactory.NewRemoteReturns(fakeImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				result, err := subject.GetBuiltImage(build)
+				require.NoError(t, err)
+
+				assert.Equal(t, []v1alpha1.BOMEntry{
+					{
+						Name:      "openjdk-jre",
+						Version:   "11.0.5",
+						Buildpack: v1alpha1.BOMBuildpack{ID: "org.cloudfoundry.openjdk", Version: "1.0.0"},
+						SHA256:    "2b8bd3e9a6b1e1c8c0a0bd7f7e5f7b0c1d4f2f8b2d3b8f6c9a1e0d4b7c6a5f3e",
+						URI:       "https://github.com/AdoptOpenJDK/openjdk11-binaries/releases/download/OpenJDK11U-jre_x64_linux_hotspot_11.0.5_10.tar.gz",
+						Licenses:  []string{"GPL-2.0 WITH Classpath-exception-2.0"},
+					},
+					{
+						Name:      "node_modules",
+						Buildpack: v1alpha1.BOMBuildpack{ID: "org.cloudfoundry.npm", Version: "0.0.2"},
+					},
+					{
+						Name:      "express",
+						Version:   "4.17.1",
+						Buildpack: v1alpha1.BOMBuildpack{ID: "org.cloudfoundry.npm", Version: "0.0.2"},
+						Licenses:  []string{"MIT"},
+					},
+				}, result.BOM)
+			})
+
+			it("ignores a bill of materials that is not a list of entries", func() {
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/built/image", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}], "bom": {"some": "thing"}}`))
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"gcr.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`))
+
+				mockFactory.NewRemoteReturns(fakeImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				result, err := subject.GetBuiltImage(build)
+				require.NoError(t, err)
+
+				assert.Nil(t, result.BOM)
+			})
+
+			it("verifies and records the digest of every tag", func() {
+				build := build.DeepCopy()
+				build.Spec.Tags = []string{"image/name", "image/name:b1.20191019.120000"}
+
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/image/name", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"gcr.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`))
+
+				mockFactory.NewRemoteReturns(fakeImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				result, err := subject.GetBuiltImage(build)
+				require.NoError(t, err)
+
+				assert.Equal(t, []v1alpha1.PushedTag{
+					{Tag: "image/name", Digest: "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"},
+					{Tag: "image/name:b1.20191019.120000", Digest: "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"},
+				}, result.Tags)
+
+				require.Equal(t, 2, mockFactory.NewRemoteCallCount())
+				tag, _ := mockFactory.NewRemoteArgsForCall(1)
+				assert.Equal(t, "image/name:b1.20191019.120000", tag)
+			})
+
+			it("records a tag that was overwritten by another image without a digest", func() {
+				build := build.DeepCopy()
+				build.Spec.Tags = []string{"image/name", "image/name:b1.20191019.120000"}
+
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/image/name", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"gcr.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`))
+				staleImage := registryfakes.NewFakeRemoteImage("index.docker.io/image/name", "sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0")
+
+				mockFactory.NewRemoteReturnsOnCall(0, fakeImage, nil)
+				mockFactory.NewRemoteReturnsOnCall(1, staleImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				result, err := subject.GetBuiltImage(build)
+				require.NoError(t, err)
+				assert.Equal(t, []v1alpha1.PushedTag{
+					{Tag: "image/name", Digest: "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"},
+					{Tag: "image/name:b1.20191019.120000"},
+				}, result.Tags)
+			})
+
+			it("errors when a tag still resolves to the image of the last build", func() {
+				build := build.DeepCopy()
+				build.Spec.Tags = []string{"image/name", "image/name:b1.20191019.120000"}
+				build.Spec.LastBuild.Image = "image/name@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"
+
+				fakeImage := registryfakes.NewFakeRemoteImage("index.docker.io/image/name", "sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+				assert.NoError(t, fakeImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"gcr.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"gcr.io/run:full-cnb"}}}`))
+				staleImage := registryfakes.NewFakeRemoteImage("index.docker.io/image/name", "sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0")
+
+				mockFactory.NewRemoteReturnsOnCall(0, fakeImage, nil)
+				mockFactory.NewRemoteReturnsOnCall(1, staleImage, nil)
+
+				subject := cnb.RemoteMetadataRetriever{RemoteImageFactory: mockFactory}
+
+				_, err := subject.GetBuiltImage(build)
+				require.EqualError(t, err, "tag image/name:b1.20191019.120000 was not updated and still resolves to sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0 instead of sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4")
+			})
 		})
 	})
 }
@@ -0,0 +1,61 @@
+package cnb
+
+import (
+	"sort"
+
+	"github.com/buildpack/imgutil"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+// ImageLabeler adds labels to the config of a built image and pushes the
+// labeled image to every tag of the build in the registry of the build tag.
+type ImageLabeler struct {
+	RemoteImageFactory RemoteImageUtilFactory
+}
+
+func (l *ImageLabeler) Label(build *v1alpha1.Build, labels map[string]string) (BuiltImage, error) {
+	secretRef := registry.SecretRef{
+		Namespace:      build.Namespace,
+		ServiceAccount: build.Spec.ServiceAccount,
+	}
+
+	appImage, err := l.RemoteImageFactory.newRemote(build.Tag(), build.Status.LatestImage, secretRef)
+	if err != nil {
+		return BuiltImage{}, err
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := appImage.SetLabel(name, labels[name]); err != nil {
+			return BuiltImage{}, err
+		}
+	}
+
+	err = appImage.Save(build.ExportTags()[1:]...)
+	if saveErr, ok := err.(imgutil.SaveError); ok {
+		return BuiltImage{}, tagsNotWritten(saveErr)
+	} else if err != nil {
+		return BuiltImage{}, err
+	}
+
+	builtImage, err := readBuiltImage(build, remoteImageWrapper{appImage})
+	if err != nil {
+		return BuiltImage{}, err
+	}
+
+	builtImage.Tags, err = pushedTags(build, builtImage.Identifier, func(tag string) (string, error) {
+		tagImage, err := l.RemoteImageFactory.newRemote(tag, tag, secretRef)
+		if err != nil {
+			return "", err
+		}
+		return remoteImageWrapper{tagImage}.Identifier()
+	})
+	return builtImage, err
+}
@@ -0,0 +1,122 @@
+package cnb
+
+import (
+	"testing"
+
+	"github.com/buildpack/imgutil/fakes"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestImageLabeler(t *testing.T) {
+	spec.Run(t, "ImageLabeler", testImageLabeler)
+}
+
+func testImageLabeler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		namespace           = "testNamespace"
+		buildServiceAccount = "testServiceAccount"
+		builtImage          = "testimage/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"
+		labeledImage        = "testimage/app@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+	)
+
+	var (
+		fakeRemoteImageFactory = &FakeRemoteImageUtilFactory{}
+		appImage               *fakes.Image
+		subject                = ImageLabeler{RemoteImageFactory: fakeRemoteImageFactory}
+		secretRef              = registry.SecretRef{
+			Namespace:      namespace,
+			ServiceAccount: buildServiceAccount,
+		}
+	)
+
+	build := &v1alpha1.Build{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "testBuild",
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.BuildSpec{
+			Tags:           []string{"testimage/app", "additional/tags"},
+			ServiceAccount: buildServiceAccount,
+		},
+		Status: v1alpha1.BuildStatus{
+			LatestImage: builtImage,
+		},
+	}
+
+	it.Before(func() {
+		appImage = fakes.NewImage("testimage/app", "980723452toplayer", &fakeImageIdentifier{identifier: labeledImage})
+		require.NoError(t, appImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"foo.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"foo.io/run:basecnb"}}}`))
+		require.NoError(t, appImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+
+		fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+			ImageName: "testimage/app",
+			BaseImage: builtImage,
+			SecretRef: secretRef,
+		}, appImage)
+	})
+
+	when("#Label", func() {
+		it("pushes the image with the labels to every tag of the build", func() {
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "additional/tags",
+				BaseImage: "additional/tags",
+				SecretRef: secretRef,
+			}, fakes.NewImage("additional/tags", "980723452toplayer", &fakeImageIdentifier{identifier: "additional/tags@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"}))
+
+			image, err := subject.Label(build, map[string]string{
+				"org.opencontainers.image.revision": "abcdef",
+				"com.example.team":                  "payments",
+			})
+			require.NoError(t, err)
+
+			revision, err := appImage.Label("org.opencontainers.image.revision")
+			require.NoError(t, err)
+			assert.Equal(t, "abcdef", revision)
+			team, err := appImage.Label("com.example.team")
+			require.NoError(t, err)
+			assert.Equal(t, "payments", team)
+
+			assert.Len(t, appImage.SavedNames(), 2)
+			assert.Contains(t, appImage.SavedNames(), "testimage/app")
+			assert.Contains(t, appImage.SavedNames(), "additional/tags")
+
+			assert.Equal(t, labeledImage, image.Identifier)
+			assert.Equal(t, []v1alpha1.PushedTag{
+				{Tag: "testimage/app", Digest: "sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"},
+				{Tag: "additional/tags", Digest: "sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"},
+			}, image.Tags)
+		})
+
+		it("errors when an additional tag still resolves to the exported image", func() {
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "additional/tags",
+				BaseImage: "additional/tags",
+				SecretRef: secretRef,
+			}, fakes.NewImage("additional/tags", "980723452toplayer", &fakeImageIdentifier{identifier: builtImage}))
+
+			_, err := subject.Label(build, map[string]string{"com.example.team": "payments"})
+			require.EqualError(t, err, "tag additional/tags was not updated and still resolves to sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0 instead of sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db")
+		})
+
+		it("records an additional tag that was overwritten by another image without a digest", func() {
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "additional/tags",
+				BaseImage: "additional/tags",
+				SecretRef: secretRef,
+			}, fakes.NewImage("additional/tags", "980723452toplayer", &fakeImageIdentifier{identifier: "testimage/app@sha256:dc7e5e790001c71c2cfb175854dd36e65e0b71c58294b331a519be95bdec4ef4"}))
+
+			image, err := subject.Label(build, map[string]string{"com.example.team": "payments"})
+			require.NoError(t, err)
+			assert.Equal(t, []v1alpha1.PushedTag{
+				{Tag: "testimage/app", Digest: "sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"},
+				{Tag: "additional/tags"},
+			}, image.Tags)
+		})
+	})
+}
@@ -2,8 +2,9 @@ package cnb
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/buildpack/imgutil"
@@ -21,50 +22,89 @@ type ImageRebaser struct {
 }
 
 func (f *ImageRebaser) Rebase(build *v1alpha1.Build, ctx context.Context) (BuiltImage, error) {
-	builderImage, err := f.RemoteImageFactory.newRemote(build.Spec.Builder.Image, build.Spec.Builder.Image, registry.SecretRef{
-		Namespace:        build.Namespace,
-		ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
-	})
+	secretRef := registry.SecretRef{
+		Namespace:      build.Namespace,
+		ServiceAccount: build.Spec.ServiceAccount,
+	}
+
+	appImage, err := f.RemoteImageFactory.newRemote(build.Tag(), build.Spec.LastBuild.Image, secretRef)
 	if err != nil {
 		return BuiltImage{}, err
 	}
 
-	appImage, err := f.RemoteImageFactory.newRemote(build.Tag(), build.Spec.LastBuild.Image, registry.SecretRef{
-		Namespace:      build.Namespace,
-		ServiceAccount: build.Spec.ServiceAccount,
-	})
+	newBaseImage, err := f.newBaseImage(build)
 	if err != nil {
 		return BuiltImage{}, err
 	}
 
-	metadataJSON, err := builderImage.Label(BuilderMetadataLabel)
-	if err != nil {
-		return BuiltImage{}, errors.Wrap(err, "builder image metadata label not present")
+	rebaser := lifecycle.Rebaser{
+		Logger: wrappedLogger{logging.FromContext(ctx)},
+	}
+	err = rebaser.Rebase(appImage, newBaseImage, build.ExportTags()[1:])
+	if saveErr, ok := err.(imgutil.SaveError); ok {
+		return BuiltImage{}, tagsNotWritten(saveErr)
+	} else if err != nil {
+		return BuiltImage{}, err
 	}
 
-	var metadata BuilderImageMetadata
-	err = json.Unmarshal([]byte(metadataJSON), &metadata)
+	builtImage, err := readBuiltImage(build, remoteImageWrapper{appImage})
 	if err != nil {
-		return BuiltImage{}, errors.Wrap(err, "unsupported builder metadata structure")
+		return BuiltImage{}, err
 	}
 
-	newBaseImage, err := f.RemoteImageFactory.newRemote(metadata.Stack.RunImage.Image, metadata.Stack.RunImage.Image, registry.SecretRef{
+	builtImage.Tags, err = pushedTags(build, builtImage.Identifier, func(tag string) (string, error) {
+		tagImage, err := f.RemoteImageFactory.newRemote(tag, tag, secretRef)
+		if err != nil {
+			return "", err
+		}
+		return remoteImageWrapper{tagImage}.Identifier()
+	})
+	return builtImage, err
+}
+
+// tagsNotWritten reports the cause for each tag because the lifecycle only
+// names the tags that failed.
+func tagsNotWritten(saveErr imgutil.SaveError) error {
+	var failures []string
+	for _, d := range saveErr.Errors {
+		failures = append(failures, fmt.Sprintf("%s: %s", d.ImageName, d.Cause))
+	}
+	return errors.Errorf("failed to write image to the following tags: %s", strings.Join(failures, ", "))
+}
+
+// newBaseImage uses the run image resolved for the build when there is one so
+// that the builder does not need to be pulled.
+func (f *ImageRebaser) newBaseImage(build *v1alpha1.Build) (imgutil.Image, error) {
+	if build.Spec.RunImage != "" {
+		return f.RemoteImageFactory.newRemote(build.Spec.RunImage, build.Spec.RunImage, registry.SecretRef{
+			Namespace:        build.Namespace,
+			ServiceAccount:   build.Spec.ServiceAccount,
+			ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
+		})
+	}
+
+	builderImage, err := f.RemoteImageFactory.newRemote(build.Spec.Builder.Image, build.Spec.Builder.Image, registry.SecretRef{
 		Namespace:        build.Namespace,
 		ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
 	})
 	if err != nil {
-		return BuiltImage{}, err
+		return nil, err
 	}
 
-	rebaser := lifecycle.Rebaser{
-		Logger: wrappedLogger{logging.FromContext(ctx)},
+	metadata, err := ReadBuilderMetadata(builderImage)
+	if err != nil {
+		return nil, err
 	}
-	err = rebaser.Rebase(appImage, newBaseImage, build.Spec.Tags[1:])
+
+	runImage, err := RunImageForTag(metadata.Stack, build.Tag())
 	if err != nil {
-		return BuiltImage{}, err
+		return nil, err
 	}
 
-	return readBuiltImage(remoteImageWrapper{appImage})
+	return f.RemoteImageFactory.newRemote(runImage, runImage, registry.SecretRef{
+		Namespace:        build.Namespace,
+		ImagePullSecrets: build.Spec.Builder.ImagePullSecrets,
+	})
 }
 
 type remoteImageWrapper struct {
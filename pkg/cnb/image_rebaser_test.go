@@ -92,6 +92,14 @@ func testImageRebaser(t *testing.T, when spec.G, it spec.S) {
 					ImagePullSecrets: builderPullSecrets,
 				},
 			}, newRunImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "additional/tags",
+				BaseImage: "additional/tags",
+				SecretRef: registry.SecretRef{
+					Namespace:      namespace,
+					ServiceAccount: buildServiceAccount,
+				},
+			}, fakes.NewImage("additional/tags", "980723452toplayer", &fakeImageIdentifier{identifier: "additional/tags@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"}))
 
 			imgRebaser = ImageRebaser{
 				RemoteImageFactory: fakeRemoteImageFactory,
@@ -105,6 +113,200 @@ func testImageRebaser(t *testing.T, when spec.G, it spec.S) {
 			assert.Len(t, appImage.SavedNames(), 2)
 			assert.Contains(t, appImage.SavedNames(), "testimage/app")
 			assert.Contains(t, appImage.SavedNames(), "additional/tags")
+
+			assert.Equal(t, []v1alpha1.PushedTag{
+				{Tag: "testimage/app", Digest: "sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+				{Tag: "additional/tags", Digest: "sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+			}, rebasedImage.Tags)
+		})
+
+		it("records an additional tag that does not resolve to the rebased image without a digest", func() {
+			build := &v1alpha1.Build{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "testBuild",
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.BuildSpec{
+					Tags: []string{"testimage/app", "additional/tags"},
+					Builder: v1alpha1.BuildBuilderSpec{
+						Image:            builder,
+						ImagePullSecrets: builderPullSecrets,
+					},
+					ServiceAccount: buildServiceAccount,
+					LastBuild:      v1alpha1.LastBuild{Image: "testimage/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+					RunImage:       "foo.io/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8",
+				},
+			}
+
+			appImage := fakes.NewImage("testimage/app", "980723452toplayer", &fakeImageIdentifier{identifier: "testimage/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"})
+			require.NoError(t, appImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"foo.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"foo.io/run:basecnb"}}}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			newRunImage := fakes.NewImage(build.Spec.RunImage, "0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", &fakeImageIdentifier{identifier: build.Spec.RunImage})
+			require.NoError(t, newRunImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			fakeRemoteImageFactory = &FakeRemoteImageUtilFactory{}
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "testimage/app",
+				BaseImage: build.Spec.LastBuild.Image,
+				SecretRef: registry.SecretRef{
+					Namespace:      namespace,
+					ServiceAccount: buildServiceAccount,
+				},
+			}, appImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: build.Spec.RunImage,
+				BaseImage: build.Spec.RunImage,
+				SecretRef: registry.SecretRef{
+					Namespace:        namespace,
+					ServiceAccount:   buildServiceAccount,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}, newRunImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "additional/tags",
+				BaseImage: "additional/tags",
+				SecretRef: registry.SecretRef{
+					Namespace:      namespace,
+					ServiceAccount: buildServiceAccount,
+				},
+			}, fakes.NewImage("additional/tags", "980723452toplayer", &fakeImageIdentifier{identifier: "additional/tags@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"}))
+
+			imgRebaser = ImageRebaser{
+				RemoteImageFactory: fakeRemoteImageFactory,
+			}
+
+			rebasedImage, err := imgRebaser.Rebase(build, context.TODO())
+			require.NoError(t, err)
+			assert.Equal(t, []v1alpha1.PushedTag{
+				{Tag: "testimage/app", Digest: "sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+				{Tag: "additional/tags"},
+			}, rebasedImage.Tags)
+		})
+
+		it("rebases on the run image mirror in the registry of the tag", func() {
+			build := &v1alpha1.Build{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "testBuild",
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.BuildSpec{
+					Tags: []string{"some.registry.io/app"},
+					Builder: v1alpha1.BuildBuilderSpec{
+						Image:            builder,
+						ImagePullSecrets: builderPullSecrets,
+					},
+					ServiceAccount: buildServiceAccount,
+					LastBuild:      v1alpha1.LastBuild{Image: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+				},
+			}
+
+			const stackMetadata = `{"runImage": {"image": "foo.io/run:basecnb", "mirrors": ["some.registry.io/run:basecnb"]}}`
+
+			builderImage := fakes.NewImage("testbuilder/builder", "293847toplayer", &fakeImageIdentifier{identifier: "builder"})
+			require.NoError(t, builderImage.SetLabel("io.buildpacks.builder.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}], "stack": `+stackMetadata+`}`))
+
+			appImage := fakes.NewImage("some.registry.io/app", "980723452toplayer", &fakeImageIdentifier{identifier: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"})
+			require.NoError(t, appImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"some.registry.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":`+stackMetadata+`}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			mirrorRunImage := fakes.NewImage("some.registry.io/run:basecnb", "0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", &fakeImageIdentifier{identifier: "some.registry.io/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8"})
+			require.NoError(t, mirrorRunImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: builder,
+				BaseImage: builder,
+				SecretRef: registry.SecretRef{
+					Namespace:        namespace,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}, builderImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(
+				newRemoteArgs{
+					ImageName: "some.registry.io/app",
+					BaseImage: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0",
+					SecretRef: registry.SecretRef{
+						Namespace:      namespace,
+						ServiceAccount: buildServiceAccount,
+					},
+				}, appImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: "some.registry.io/run:basecnb",
+				BaseImage: "some.registry.io/run:basecnb",
+				SecretRef: registry.SecretRef{
+					Namespace:        namespace,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}, mirrorRunImage)
+
+			imgRebaser = ImageRebaser{
+				RemoteImageFactory: fakeRemoteImageFactory,
+			}
+
+			rebasedImage, err := imgRebaser.Rebase(build, context.TODO())
+			require.NoError(t, err)
+
+			assert.Equal(t, "some.registry.io/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8", rebasedImage.RunImage)
+		})
+
+		it("rebases on the run image of the build without pulling the builder", func() {
+			const runImage = "some.registry.io/run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8"
+
+			build := &v1alpha1.Build{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "testBuild",
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.BuildSpec{
+					Tags: []string{"some.registry.io/app"},
+					Builder: v1alpha1.BuildBuilderSpec{
+						Image:            builder,
+						ImagePullSecrets: builderPullSecrets,
+					},
+					ServiceAccount: buildServiceAccount,
+					LastBuild:      v1alpha1.LastBuild{Image: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},
+					RunImage:       runImage,
+				},
+			}
+
+			appImage := fakes.NewImage("some.registry.io/app", "980723452toplayer", &fakeImageIdentifier{identifier: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"})
+			require.NoError(t, appImage.SetLabel("io.buildpacks.lifecycle.metadata", `{"runImage":{"topLayer":"sha256:719f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409","reference":"some.registry.io/run@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0"},"stack":{"runImage":{"image":"some.registry.io/run"}}}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`))
+			require.NoError(t, appImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			newRunImage := fakes.NewImage(runImage, "0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0", &fakeImageIdentifier{identifier: runImage})
+			require.NoError(t, newRunImage.SetLabel("io.buildpacks.stack.id", "io.buildpacks.stacks.bionic"))
+
+			fakeRemoteImageFactory = &FakeRemoteImageUtilFactory{}
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(
+				newRemoteArgs{
+					ImageName: "some.registry.io/app",
+					BaseImage: "some.registry.io/app@sha256:0fd6395e4fe38a0c089665cbe10f52fb26fc64b4b15e672ada412bd7ab5499a0",
+					SecretRef: registry.SecretRef{
+						Namespace:      namespace,
+						ServiceAccount: buildServiceAccount,
+					},
+				}, appImage)
+			fakeRemoteImageFactory.NewRemoteReturnsForArgs(newRemoteArgs{
+				ImageName: runImage,
+				BaseImage: runImage,
+				SecretRef: registry.SecretRef{
+					Namespace:        namespace,
+					ServiceAccount:   buildServiceAccount,
+					ImagePullSecrets: builderPullSecrets,
+				},
+			}, newRunImage)
+
+			imgRebaser = ImageRebaser{
+				RemoteImageFactory: fakeRemoteImageFactory,
+			}
+
+			rebasedImage, err := imgRebaser.Rebase(build, context.TODO())
+			require.NoError(t, err)
+
+			assert.Equal(t, runImage, rebasedImage.RunImage)
 		})
 	})
 }
@@ -0,0 +1,407 @@
+package cnb
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	// SigningKeySecretKey is the key of the PEM encoded private key in a
+	// signing Secret.
+	SigningKeySecretKey = "key.pem"
+
+	SignatureMediaType   types.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	AttestationMediaType types.MediaType = "application/vnd.dsse.envelope.v1+json"
+	SignatureAnnotation                  = "dev.cosignproject.cosign/signature"
+
+	ProvenanceBuilderID = "https://github.com/pivotal/kpack"
+	ProvenanceBuildType = "https://github.com/pivotal/kpack/Build@v1alpha1"
+
+	inTotoStatementType  = "https://in-toto.io/Statement/v0.1"
+	inTotoPayloadType    = "application/vnd.in-toto+json"
+	slsaProvenanceType   = "https://slsa.dev/provenance/v0.2"
+	simpleSigningType    = "cosign container image signature"
+	signatureTagSuffix   = "sig"
+	attestationTagSuffix = "att"
+)
+
+// RemoteImageSigner signs the image of a successful build and pushes a SLSA
+// provenance attestation for it. Both are stored next to the image using the
+// tag layout of cosign so that they can be verified with its tooling.
+type RemoteImageSigner struct {
+	K8sClient       k8sclient.Interface
+	KeychainFactory registry.KeychainFactory
+}
+
+func (s *RemoteImageSigner) Sign(build *v1alpha1.Build) (v1alpha1.BuildSignature, error) {
+	key, err := s.signingKey(build)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	keychain, err := s.KeychainFactory.KeychainForSecretRef(registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccount,
+		Namespace:      build.Namespace,
+	})
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	digest, err := name.NewDigest(build.Status.LatestImage, name.WeakValidation)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	payload, err := json.Marshal(simpleSigningPayload(digest))
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	signature, err := sign(key, payload)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	signatureTag, err := pushArtifact(digest, signatureTagSuffix, payload, SignatureMediaType, map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}, keychain)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, errors.Wrap(err, "unable to push signature")
+	}
+
+	statement, err := json.Marshal(provenance(build, digest))
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	envelope, err := dsseEnvelope(key, statement)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, err
+	}
+
+	attestationTag, err := pushArtifact(digest, attestationTagSuffix, envelope, AttestationMediaType, nil, keychain)
+	if err != nil {
+		return v1alpha1.BuildSignature{}, errors.Wrap(err, "unable to push attestation")
+	}
+
+	return v1alpha1.BuildSignature{
+		Signature:   signatureTag,
+		Attestation: attestationTag,
+	}, nil
+}
+
+func (s *RemoteImageSigner) signingKey(build *v1alpha1.Build) (*ecdsa.PrivateKey, error) {
+	secret, err := s.K8sClient.CoreV1().Secrets(build.Namespace).Get(build.Spec.SigningSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSigningKey(secret.Data[SigningKeySecretKey])
+}
+
+func parseSigningKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Errorf("signing secret does not contain a PEM encoded key in %s", SigningKeySecretKey)
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse signing key")
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key must be an ECDSA private key")
+	}
+	return ecdsaKey, nil
+}
+
+func sign(key *ecdsa.PrivateKey, payload []byte) ([]byte, error) {
+	hash := sha256.Sum256(payload)
+	return key.Sign(rand.Reader, hash[:], crypto.SHA256)
+}
+
+type simpleSigning struct {
+	Critical simpleSigningCritical `json:"critical"`
+	Optional map[string]string     `json:"optional"`
+}
+
+type simpleSigningCritical struct {
+	Identity simpleSigningIdentity `json:"identity"`
+	Image    simpleSigningImage    `json:"image"`
+	Type     string                `json:"type"`
+}
+
+type simpleSigningIdentity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type simpleSigningImage struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+func simpleSigningPayload(digest name.Digest) simpleSigning {
+	return simpleSigning{
+		Critical: simpleSigningCritical{
+			Identity: simpleSigningIdentity{DockerReference: digest.Context().Name()},
+			Image:    simpleSigningImage{DockerManifestDigest: digest.DigestStr()},
+			Type:     simpleSigningType,
+		},
+	}
+}
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	PredicateType string          `json:"predicateType"`
+	Subject       []inTotoSubject `json:"subject"`
+	Predicate     slsaProvenance  `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type slsaProvenance struct {
+	Builder     slsaBuilder     `json:"builder"`
+	BuildType   string          `json:"buildType"`
+	Invocation  slsaInvocation  `json:"invocation"`
+	BuildConfig slsaBuildConfig `json:"buildConfig"`
+	Metadata    slsaMetadata    `json:"metadata"`
+	Materials   []slsaMaterial  `json:"materials"`
+}
+
+type slsaBuilder struct {
+	ID string `json:"id"`
+}
+
+type slsaInvocation struct {
+	ConfigSource slsaMaterial   `json:"configSource"`
+	Parameters   slsaParameters `json:"parameters"`
+}
+
+type slsaParameters struct {
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+type slsaBuildConfig struct {
+	Buildpacks []slsaBuildpack `json:"buildpacks"`
+}
+
+type slsaBuildpack struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+type slsaMetadata struct {
+	BuildStartedOn  *time.Time `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn *time.Time `json:"buildFinishedOn,omitempty"`
+}
+
+type slsaMaterial struct {
+	URI        string            `json:"uri"`
+	Digest     map[string]string `json:"digest,omitempty"`
+	EntryPoint string            `json:"entryPoint,omitempty"`
+}
+
+func provenance(build *v1alpha1.Build, digest name.Digest) inTotoStatement {
+	source := sourceMaterial(build.Spec.Source)
+
+	var buildpacks []slsaBuildpack
+	for _, bp := range build.Status.BuildMetadata {
+		buildpacks = append(buildpacks, slsaBuildpack{ID: bp.ID, Version: bp.Version})
+	}
+
+	var reasons []string
+	if annotation := build.Annotations[v1alpha1.BuildReasonAnnotation]; annotation != "" {
+		reasons = strings.Split(annotation, ",")
+	}
+
+	return inTotoStatement{
+		Type:          inTotoStatementType,
+		PredicateType: slsaProvenanceType,
+		Subject: []inTotoSubject{
+			{
+				Name:   digest.Context().Name(),
+				Digest: imageDigest(digest),
+			},
+		},
+		Predicate: slsaProvenance{
+			Builder:   slsaBuilder{ID: ProvenanceBuilderID},
+			BuildType: ProvenanceBuildType,
+			Invocation: slsaInvocation{
+				ConfigSource: source,
+				Parameters:   slsaParameters{Reasons: reasons},
+			},
+			BuildConfig: slsaBuildConfig{Buildpacks: buildpacks},
+			Metadata: slsaMetadata{
+				BuildStartedOn:  timeOrNil(build.Status.StartTime),
+				BuildFinishedOn: timeOrNil(build.Status.CompletionTime),
+			},
+			Materials: []slsaMaterial{
+				{URI: source.URI, Digest: source.Digest},
+				imageMaterial(build.Spec.Builder.Image),
+				imageMaterial(build.Status.RunImage),
+			},
+		},
+	}
+}
+
+func sourceMaterial(source v1alpha1.SourceConfig) slsaMaterial {
+	switch {
+	case source.Git != nil:
+		return slsaMaterial{
+			URI:        source.Git.URL,
+			Digest:     map[string]string{"sha1": source.Git.Revision},
+			EntryPoint: source.SubPath,
+		}
+	case source.Blob != nil:
+		return slsaMaterial{URI: source.Blob.URL, EntryPoint: source.SubPath}
+	case source.Registry != nil:
+		material := imageMaterial(source.Registry.Image)
+		material.EntryPoint = source.SubPath
+		return material
+	default:
+		return slsaMaterial{}
+	}
+}
+
+// imageMaterial records an image by repository and digest when the reference
+// is pinned to a digest.
+func imageMaterial(image string) slsaMaterial {
+	digest, err := name.NewDigest(image, name.WeakValidation)
+	if err != nil {
+		return slsaMaterial{URI: image}
+	}
+	return slsaMaterial{URI: digest.Context().Name(), Digest: imageDigest(digest)}
+}
+
+func imageDigest(digest name.Digest) map[string]string {
+	parts := strings.SplitN(digest.DigestStr(), ":", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	return map[string]string{parts[0]: parts[1]}
+}
+
+func timeOrNil(t *metav1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.Time.UTC()
+	return &utc
+}
+
+type dsse struct {
+	PayloadType string          `json:"payloadType"`
+	Payload     string          `json:"payload"`
+	Signatures  []dsseSignature `json:"signatures"`
+}
+
+type dsseSignature struct {
+	Sig string `json:"sig"`
+}
+
+func dsseEnvelope(key *ecdsa.PrivateKey, statement []byte) ([]byte, error) {
+	signature, err := sign(key, preAuthEncoding(inTotoPayloadType, statement))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(dsse{
+		PayloadType: inTotoPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(statement),
+		Signatures: []dsseSignature{
+			{Sig: base64.StdEncoding.EncodeToString(signature)},
+		},
+	})
+}
+
+// preAuthEncoding is the message that is signed for a DSSE envelope.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// pushArtifact writes content as the only layer of an image tagged
+// sha256-<digest>.<suffix> in the repository of the signed image.
+func pushArtifact(digest name.Digest, suffix string, content []byte, mediaType types.MediaType, annotations map[string]string, keychain authn.Keychain) (string, error) {
+	tag, err := name.NewTag(fmt.Sprintf("%s:%s.%s", digest.Context().Name(), strings.Replace(digest.DigestStr(), ":", "-", 1), suffix), name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	image, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer:       &staticLayer{content: content, mediaType: mediaType},
+		Annotations: annotations,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := remote.Write(tag, image, remote.WithAuthFromKeychain(keychain)); err != nil {
+		return "", err
+	}
+	return tag.Name(), nil
+}
+
+// staticLayer is an uncompressed layer with fixed content and media type.
+type staticLayer struct {
+	content   []byte
+	mediaType types.MediaType
+}
+
+func (l *staticLayer) Digest() (v1.Hash, error) {
+	hash, _, err := v1.SHA256(bytes.NewReader(l.content))
+	return hash, err
+}
+
+func (l *staticLayer) DiffID() (v1.Hash, error) {
+	return l.Digest()
+}
+
+func (l *staticLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l *staticLayer) Uncompressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l *staticLayer) Size() (int64, error) {
+	return int64(len(l.content)), nil
+}
+
+func (l *staticLayer) MediaType() (types.MediaType, error) {
+	return l.mediaType, nil
+}
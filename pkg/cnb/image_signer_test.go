@@ -0,0 +1,265 @@
+package cnb_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestImageSigner(t *testing.T) {
+	spec.Run(t, "Image Signer", testImageSigner)
+}
+
+func testImageSigner(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server          *httptest.Server
+		host            string
+		key             *ecdsa.PrivateKey
+		k8sClient       *fake.Clientset
+		keychainFactory *fakeKeychainFactory
+		subject         *cnb.RemoteImageSigner
+		build           *v1alpha1.Build
+		imageDigest     v1.Hash
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewServer(ggcrregistry.New())
+		host = strings.TrimPrefix(server.URL, "http://")
+
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+		push(t, host+"/app:latest", image)
+		imageDigest, err = image.Digest()
+		require.NoError(t, err)
+
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		k8sClient = fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "signing-key",
+				Namespace: "some-namespace",
+			},
+			Data: map[string][]byte{
+				cnb.SigningKeySecretKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+			},
+		})
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &cnb.RemoteImageSigner{
+			K8sClient:       k8sClient,
+			KeychainFactory: keychainFactory,
+		}
+
+		startTime := metav1.NewTime(time.Date(2019, 10, 21, 10, 0, 0, 0, time.UTC))
+		completionTime := metav1.NewTime(time.Date(2019, 10, 21, 10, 5, 0, 0, time.UTC))
+		build = &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-build",
+				Namespace: "some-namespace",
+				Annotations: map[string]string{
+					v1alpha1.BuildReasonAnnotation: "COMMIT,BUILDPACK",
+				},
+			},
+			Spec: v1alpha1.BuildSpec{
+				Tags:           []string{host + "/app:latest"},
+				ServiceAccount: "some-service-account",
+				SigningSecret:  "signing-key",
+				Builder: v1alpha1.BuildBuilderSpec{
+					Image: "some.registry.io/builder@sha256:1111111111111111111111111111111111111111111111111111111111111111",
+				},
+				Source: v1alpha1.SourceConfig{
+					Git: &v1alpha1.Git{
+						URL:      "https://github.com/some/app",
+						Revision: "abcdef1234567890abcdef1234567890abcdef12",
+					},
+					SubPath: "some/path",
+				},
+			},
+			Status: v1alpha1.BuildStatus{
+				LatestImage: host + "/app@" + imageDigest.String(),
+				RunImage:    "some.registry.io/run@sha256:2222222222222222222222222222222222222222222222222222222222222222",
+				BuildMetadata: v1alpha1.BuildpackMetadataList{
+					{ID: "org.one", Version: "1.0.0"},
+					{ID: "org.two", Version: "2.0.0"},
+				},
+				StartTime:      &startTime,
+				CompletionTime: &completionTime,
+			},
+		}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	artifactTag := func(suffix string) string {
+		return fmt.Sprintf("%s/app:sha256-%s.%s", host, imageDigest.Hex, suffix)
+	}
+
+	verify := func(signature string, payload []byte) bool {
+		decoded, err := base64.StdEncoding.DecodeString(signature)
+		require.NoError(t, err)
+		hash := sha256.Sum256(payload)
+		return ecdsa.VerifyASN1(&key.PublicKey, hash[:], decoded)
+	}
+
+	it("uses the service account of the build to push", func() {
+		_, err := subject.Sign(build)
+		require.NoError(t, err)
+
+		assert.Equal(t, registry.SecretRef{
+			ServiceAccount: "some-service-account",
+			Namespace:      "some-namespace",
+		}, keychainFactory.secretRef)
+	})
+
+	it("pushes a signature of the image digest", func() {
+		signature, err := subject.Sign(build)
+		require.NoError(t, err)
+		assert.Equal(t, artifactTag("sig"), signature.Signature)
+
+		manifest, payload := pulledArtifact(t, signature.Signature)
+		require.Len(t, manifest.Layers, 1)
+		assert.Equal(t, cnb.SignatureMediaType, manifest.Layers[0].MediaType)
+
+		assert.JSONEq(t, fmt.Sprintf(`{
+  "critical": {
+    "identity": {"docker-reference": "%s/app"},
+    "image": {"docker-manifest-digest": "%s"},
+    "type": "cosign container image signature"
+  },
+  "optional": null
+}`, host, imageDigest), string(payload))
+
+		assert.True(t, verify(manifest.Layers[0].Annotations[cnb.SignatureAnnotation], payload))
+	})
+
+	it("pushes a signed provenance attestation", func() {
+		signature, err := subject.Sign(build)
+		require.NoError(t, err)
+		assert.Equal(t, artifactTag("att"), signature.Attestation)
+
+		manifest, content := pulledArtifact(t, signature.Attestation)
+		require.Len(t, manifest.Layers, 1)
+		assert.Equal(t, cnb.AttestationMediaType, manifest.Layers[0].MediaType)
+
+		var envelope struct {
+			PayloadType string `json:"payloadType"`
+			Payload     string `json:"payload"`
+			Signatures  []struct {
+				Sig string `json:"sig"`
+			} `json:"signatures"`
+		}
+		require.NoError(t, json.Unmarshal(content, &envelope))
+		assert.Equal(t, "application/vnd.in-toto+json", envelope.PayloadType)
+
+		statement, err := base64.StdEncoding.DecodeString(envelope.Payload)
+		require.NoError(t, err)
+		require.Len(t, envelope.Signatures, 1)
+		pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(envelope.PayloadType), envelope.PayloadType, len(statement), statement)
+		assert.True(t, verify(envelope.Signatures[0].Sig, []byte(pae)))
+
+		assert.JSONEq(t, fmt.Sprintf(`{
+  "_type": "https://in-toto.io/Statement/v0.1",
+  "predicateType": "https://slsa.dev/provenance/v0.2",
+  "subject": [{"name": "%s/app", "digest": {"sha256": "%s"}}],
+  "predicate": {
+    "builder": {"id": "https://github.com/pivotal/kpack"},
+    "buildType": "https://github.com/pivotal/kpack/Build@v1alpha1",
+    "invocation": {
+      "configSource": {
+        "uri": "https://github.com/some/app",
+        "digest": {"sha1": "abcdef1234567890abcdef1234567890abcdef12"},
+        "entryPoint": "some/path"
+      },
+      "parameters": {"reasons": ["COMMIT", "BUILDPACK"]}
+    },
+    "buildConfig": {
+      "buildpacks": [
+        {"id": "org.one", "version": "1.0.0"},
+        {"id": "org.two", "version": "2.0.0"}
+      ]
+    },
+    "metadata": {
+      "buildStartedOn": "2019-10-21T10:00:00Z",
+      "buildFinishedOn": "2019-10-21T10:05:00Z"
+    },
+    "materials": [
+      {"uri": "https://github.com/some/app", "digest": {"sha1": "abcdef1234567890abcdef1234567890abcdef12"}},
+      {"uri": "some.registry.io/builder", "digest": {"sha256": "1111111111111111111111111111111111111111111111111111111111111111"}},
+      {"uri": "some.registry.io/run", "digest": {"sha256": "2222222222222222222222222222222222222222222222222222222222222222"}}
+    ]
+  }
+}`, host, imageDigest.Hex), string(statement))
+	})
+
+	it("returns an error when the signing secret does not contain a key", func() {
+		build.Spec.SigningSecret = "missing"
+		_, err := subject.Sign(build)
+		require.Error(t, err)
+
+		_, err = k8sClient.CoreV1().Secrets("some-namespace").Create(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "missing",
+				Namespace: "some-namespace",
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = subject.Sign(build)
+		require.EqualError(t, err, "signing secret does not contain a PEM encoded key in key.pem")
+	})
+}
+
+func pulledArtifact(t *testing.T, tag string) (*v1.Manifest, []byte) {
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	require.NoError(t, err)
+	image, err := remote.Image(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	require.NoError(t, err)
+
+	manifest, err := image.Manifest()
+	require.NoError(t, err)
+
+	layers, err := image.Layers()
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	reader, err := layers[0].Compressed()
+	require.NoError(t, err)
+	defer reader.Close()
+	content, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+
+	return manifest, content
+}
@@ -0,0 +1,73 @@
+package cnb
+
+import (
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// pushedTags verifies that each of the additional tags the image was written to
+// resolves to the digest of the built image and returns those tags with that
+// digest. Tags in other registries are copied by the build reconciler. A tag
+// that still resolves to the previous image was not updated and fails the
+// verification. A tag that resolves to any other image was overwritten since,
+// e.g. by a concurrent build, and is returned without a digest.
+func pushedTags(build *v1alpha1.Build, identifier string, resolve func(tag string) (string, error)) ([]v1alpha1.PushedTag, error) {
+	digest, err := digestOf(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := previousDigests(build, digest)
+
+	tags := []v1alpha1.PushedTag{{Tag: build.Tag(), Digest: digest}}
+	for _, tag := range build.ExportTags()[1:] {
+		tagIdentifier, err := resolve(tag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to verify tag %s", tag)
+		}
+
+		tagDigest, err := digestOf(tagIdentifier)
+		if err != nil {
+			return nil, err
+		}
+
+		if previous[tagDigest] {
+			return nil, errors.Errorf("tag %s was not updated and still resolves to %s instead of %s", tag, tagDigest, digest)
+		}
+
+		if tagDigest != digest {
+			tags = append(tags, v1alpha1.PushedTag{Tag: tag})
+			continue
+		}
+		tags = append(tags, v1alpha1.PushedTag{Tag: tag, Digest: digest})
+	}
+	return tags, nil
+}
+
+// previousDigests returns the digests of the images the tags pointed at before
+// the build: the image of the last build and, when labeling, the exported image.
+func previousDigests(build *v1alpha1.Build, digest string) map[string]bool {
+	previous := map[string]bool{}
+	for _, image := range []string{build.Spec.LastBuild.Image, build.Status.LatestImage} {
+		if image == "" {
+			continue
+		}
+
+		previousDigest, err := digestOf(image)
+		if err != nil || previousDigest == digest {
+			continue
+		}
+		previous[previousDigest] = true
+	}
+	return previous
+}
+
+func digestOf(identifier string) (string, error) {
+	digest, err := name.NewDigest(identifier, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+	return digest.DigestStr(), nil
+}
@@ -0,0 +1,24 @@
+package cnb
+
+import (
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+type RemoteRunImageResolver struct {
+	RemoteImageFactory registry.RemoteImageFactory
+}
+
+// Resolve returns the digest of the run image selected by the image using the
+// credentials of its service account.
+func (r *RemoteRunImageResolver) Resolve(image *v1alpha1.Image) (string, error) {
+	remoteImage, err := r.RemoteImageFactory.NewRemote(image.Spec.RunImage.Image, registry.SecretRef{
+		ServiceAccount: image.Spec.ServiceAccount,
+		Namespace:      image.Namespace,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return remoteImage.Identifier()
+}
@@ -0,0 +1,56 @@
+package cnb_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestRemoteRunImageResolver(t *testing.T) {
+	spec.Run(t, "Remote Run Image Resolver", testRemoteRunImageResolver)
+}
+
+func testRemoteRunImageResolver(t *testing.T, when spec.G, it spec.S) {
+	var (
+		fakeFactory = &registryfakes.FakeRemoteImageFactory{}
+		subject     = &cnb.RemoteRunImageResolver{RemoteImageFactory: fakeFactory}
+
+		image = &v1alpha1.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-image",
+				Namespace: "some-namespace",
+			},
+			Spec: v1alpha1.ImageSpec{
+				Tag:            "some/app",
+				ServiceAccount: "some-sa",
+				RunImage:       &v1alpha1.ImageRunImage{Image: "some/run"},
+			},
+		}
+	)
+
+	when("#Resolve", func() {
+		it("resolves the run image to a digest with the image service account", func() {
+			fakeFactory.NewRemoteReturns(registryfakes.NewFakeRemoteImage("index.docker.io/some/run", "sha256:run-digest"), nil)
+
+			runImage, err := subject.Resolve(image)
+			require.NoError(t, err)
+			assert.Equal(t, "index.docker.io/some/run@sha256:run-digest", runImage)
+
+			require.Equal(t, 1, fakeFactory.NewRemoteCallCount())
+			imageName, secretRef := fakeFactory.NewRemoteArgsForCall(0)
+			assert.Equal(t, "some/run", imageName)
+			assert.Equal(t, registry.SecretRef{
+				ServiceAccount: "some-sa",
+				Namespace:      "some-namespace",
+			}, secretRef)
+		})
+	})
+}
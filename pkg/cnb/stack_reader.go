@@ -0,0 +1,130 @@
+package cnb
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	StackMixinsLabel = "io.buildpacks.stack.mixins"
+
+	buildMixinPrefix = "build:"
+	runMixinPrefix   = "run:"
+)
+
+type RemoteStackReader struct {
+	RemoteImageFactory registry.RemoteImageFactory
+}
+
+// Read resolves the build and run images of the stack to digests and verifies
+// that both images provide the stack id and the requested mixins.
+func (r *RemoteStackReader) Read(stack *v1alpha1.Stack) (v1alpha1.ResolvedStack, error) {
+	secretRef := serviceAccountSecretRef(stack.Spec.ServiceAccountRef)
+
+	buildImage, err := r.readStackImage(stack.Spec.BuildImage.Image, stack.Spec.ID, secretRef)
+	if err != nil {
+		return v1alpha1.ResolvedStack{}, errors.Wrap(err, "invalid build image")
+	}
+
+	runImage, err := r.readStackImage(stack.Spec.RunImage.Image, stack.Spec.ID, secretRef)
+	if err != nil {
+		return v1alpha1.ResolvedStack{}, errors.Wrap(err, "invalid run image")
+	}
+
+	for _, mixin := range stack.Spec.Mixins {
+		if !isRunMixin(mixin) && !buildImage.hasMixin(mixin) {
+			return v1alpha1.ResolvedStack{}, errors.Errorf("mixin %s is not present in the build image", mixin)
+		}
+
+		if !isBuildMixin(mixin) && !runImage.hasMixin(mixin) {
+			return v1alpha1.ResolvedStack{}, errors.Errorf("mixin %s is not present in the run image", mixin)
+		}
+	}
+
+	return v1alpha1.ResolvedStack{
+		ID: stack.Spec.ID,
+		BuildImage: v1alpha1.StackStatusImage{
+			LatestImage: buildImage.identifier,
+			Image:       stack.Spec.BuildImage.Image,
+		},
+		RunImage: v1alpha1.StackStatusImage{
+			LatestImage: runImage.identifier,
+			Image:       stack.Spec.RunImage.Image,
+		},
+		Mixins: stack.Spec.Mixins,
+	}, nil
+}
+
+type stackImage struct {
+	identifier string
+	mixins     map[string]bool
+}
+
+func (r *RemoteStackReader) readStackImage(image, stackID string, secretRef registry.SecretRef) (stackImage, error) {
+	remoteImage, err := r.RemoteImageFactory.NewRemote(image, secretRef)
+	if err != nil {
+		return stackImage{}, err
+	}
+
+	imageStackID, err := remoteImage.Label(StackIDLabel)
+	if err != nil {
+		return stackImage{}, err
+	}
+	if imageStackID != stackID {
+		return stackImage{}, errors.Errorf("image stack %q does not match stack %q", imageStackID, stackID)
+	}
+
+	mixinsJSON, err := remoteImage.Label(StackMixinsLabel)
+	if err != nil {
+		return stackImage{}, err
+	}
+
+	var mixins []string
+	if mixinsJSON != "" {
+		if err := json.Unmarshal([]byte(mixinsJSON), &mixins); err != nil {
+			return stackImage{}, errors.Wrap(err, "unsupported mixins metadata")
+		}
+	}
+
+	identifier, err := remoteImage.Identifier()
+	if err != nil {
+		return stackImage{}, err
+	}
+
+	result := stackImage{identifier: identifier, mixins: map[string]bool{}}
+	for _, mixin := range mixins {
+		result.mixins[mixin] = true
+	}
+	return result, nil
+}
+
+// serviceAccountSecretRef pulls the images of cluster scoped resources
+// anonymously unless they reference a service account.
+func serviceAccountSecretRef(ref *corev1.ObjectReference) registry.SecretRef {
+	if ref == nil {
+		return registry.SecretRef{}
+	}
+
+	return registry.SecretRef{
+		ServiceAccount: ref.Name,
+		Namespace:      ref.Namespace,
+	}
+}
+
+func (i stackImage) hasMixin(mixin string) bool {
+	return i.mixins[mixin]
+}
+
+func isBuildMixin(mixin string) bool {
+	return strings.HasPrefix(mixin, buildMixinPrefix)
+}
+
+func isRunMixin(mixin string) bool {
+	return strings.HasPrefix(mixin, runMixinPrefix)
+}
@@ -0,0 +1,115 @@
+package cnb_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestRemoteStackReader(t *testing.T) {
+	spec.Run(t, "Remote Stack Reader", testRemoteStackReader)
+}
+
+func testRemoteStackReader(t *testing.T, when spec.G, it spec.S) {
+	const stackID = "io.buildpacks.stacks.bionic"
+
+	var (
+		fakeFactory = &registryfakes.FakeRemoteImageFactory{}
+		subject     = &cnb.RemoteStackReader{RemoteImageFactory: fakeFactory}
+
+		buildImage = registryfakes.NewFakeRemoteImage("index.docker.io/some/build", "sha256:build-digest")
+		runImage   = registryfakes.NewFakeRemoteImage("index.docker.io/some/run", "sha256:run-digest")
+
+		stack = &v1alpha1.Stack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "some-stack",
+			},
+			Spec: v1alpha1.StackSpec{
+				ID:         stackID,
+				BuildImage: v1alpha1.StackSpecImage{Image: "some/build"},
+				RunImage:   v1alpha1.StackSpecImage{Image: "some/run"},
+				Mixins:     []string{"shared-mixin", "build:build-mixin", "run:run-mixin"},
+			},
+		}
+	)
+
+	it.Before(func() {
+		require.NoError(t, buildImage.SetLabel(cnb.StackIDLabel, stackID))
+		require.NoError(t, buildImage.SetLabel(cnb.StackMixinsLabel, `["shared-mixin", "build:build-mixin"]`))
+		require.NoError(t, runImage.SetLabel(cnb.StackIDLabel, stackID))
+		require.NoError(t, runImage.SetLabel(cnb.StackMixinsLabel, `["shared-mixin", "run:run-mixin"]`))
+
+		fakeFactory.NewRemoteStub = func(image string, _ registry.SecretRef) (registry.RemoteImage, error) {
+			if image == "some/build" {
+				return buildImage, nil
+			}
+			return runImage, nil
+		}
+	})
+
+	when("#Read", func() {
+		it("resolves the stack images to digests", func() {
+			resolved, err := subject.Read(stack)
+			require.NoError(t, err)
+
+			assert.Equal(t, v1alpha1.ResolvedStack{
+				ID: stackID,
+				BuildImage: v1alpha1.StackStatusImage{
+					LatestImage: "index.docker.io/some/build@sha256:build-digest",
+					Image:       "some/build",
+				},
+				RunImage: v1alpha1.StackStatusImage{
+					LatestImage: "index.docker.io/some/run@sha256:run-digest",
+					Image:       "some/run",
+				},
+				Mixins: []string{"shared-mixin", "build:build-mixin", "run:run-mixin"},
+			}, resolved)
+
+			_, secretRef := fakeFactory.NewRemoteArgsForCall(0)
+			assert.Equal(t, registry.SecretRef{}, secretRef)
+		})
+
+		it("pulls the images with the secrets of the referenced service account", func() {
+			stack.Spec.ServiceAccountRef = &corev1.ObjectReference{Name: "some-sa", Namespace: "some-namespace"}
+
+			_, err := subject.Read(stack)
+			require.NoError(t, err)
+
+			require.Equal(t, 2, fakeFactory.NewRemoteCallCount())
+			for i := 0; i < 2; i++ {
+				_, secretRef := fakeFactory.NewRemoteArgsForCall(i)
+				assert.Equal(t, registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}, secretRef)
+			}
+		})
+
+		it("errors when an image does not match the stack id", func() {
+			require.NoError(t, runImage.SetLabel(cnb.StackIDLabel, "some.other.stack"))
+
+			_, err := subject.Read(stack)
+			assert.EqualError(t, err, `invalid run image: image stack "some.other.stack" does not match stack "io.buildpacks.stacks.bionic"`)
+		})
+
+		it("errors when a mixin is missing from an image", func() {
+			require.NoError(t, runImage.SetLabel(cnb.StackMixinsLabel, `["run:run-mixin"]`))
+
+			_, err := subject.Read(stack)
+			assert.EqualError(t, err, "mixin shared-mixin is not present in the run image")
+		})
+
+		it("errors when a build mixin is missing from the build image", func() {
+			require.NoError(t, buildImage.SetLabel(cnb.StackMixinsLabel, `["shared-mixin"]`))
+
+			_, err := subject.Read(stack)
+			assert.EqualError(t, err, "mixin build:build-mixin is not present in the build image")
+		})
+	})
+}
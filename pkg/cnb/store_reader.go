@@ -0,0 +1,79 @@
+package cnb
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+type RemoteStoreReader struct {
+	RemoteImageFactory registry.RemoteImageFactory
+}
+
+// Read resolves every store source to a digest and lists the buildpacks it
+// provides. When a buildpack version is provided by more than one source the
+// first source wins.
+func (r *RemoteStoreReader) Read(store *v1alpha1.Store) ([]v1alpha1.StoreBuildpack, error) {
+	var buildpacks []v1alpha1.StoreBuildpack
+	seen := map[BuildpackMetadata]bool{}
+	secretRef := serviceAccountSecretRef(store.Spec.ServiceAccountRef)
+
+	for _, source := range store.Spec.Sources {
+		remoteImage, err := r.RemoteImageFactory.NewRemote(source.Image, secretRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to fetch store image %s", source.Image)
+		}
+
+		identifier, err := remoteImage.Identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		layerMetadataJSON, err := remoteImage.Label(BuildpackLayersLabel)
+		if err != nil {
+			return nil, err
+		}
+		if layerMetadataJSON == "" {
+			return nil, errors.Errorf("store image %s is missing label %s", source.Image, BuildpackLayersLabel)
+		}
+
+		var layerMetadata BuildpackLayerMetadata
+		if err := json.Unmarshal([]byte(layerMetadataJSON), &layerMetadata); err != nil {
+			return nil, errors.Wrapf(err, "unsupported buildpack layers metadata in %s", source.Image)
+		}
+
+		for id, versions := range layerMetadata {
+			for version, info := range versions {
+				key := BuildpackMetadata{ID: id, Version: version}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				buildpack := v1alpha1.StoreBuildpack{
+					ID:         id,
+					Version:    version,
+					StoreImage: v1alpha1.StoreImage{Image: identifier},
+					DiffID:     info.LayerDiffID,
+					API:        info.API,
+				}
+				for _, stack := range info.Stacks {
+					buildpack.Stacks = append(buildpack.Stacks, v1alpha1.BuildpackStack{ID: stack.ID})
+				}
+				buildpacks = append(buildpacks, buildpack)
+			}
+		}
+	}
+
+	sort.SliceStable(buildpacks, func(i, j int) bool {
+		if buildpacks[i].ID != buildpacks[j].ID {
+			return buildpacks[i].ID < buildpacks[j].ID
+		}
+		return buildpacks[i].Version < buildpacks[j].Version
+	})
+	return buildpacks, nil
+}
@@ -0,0 +1,112 @@
+package cnb_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestRemoteStoreReader(t *testing.T) {
+	spec.Run(t, "Remote Store Reader", testRemoteStoreReader)
+}
+
+func testRemoteStoreReader(t *testing.T, when spec.G, it spec.S) {
+	var (
+		fakeFactory = &registryfakes.FakeRemoteImageFactory{}
+		subject     = &cnb.RemoteStoreReader{RemoteImageFactory: fakeFactory}
+
+		firstImage  = registryfakes.NewFakeRemoteImage("index.docker.io/some/first", "sha256:first-digest")
+		secondImage = registryfakes.NewFakeRemoteImage("index.docker.io/some/second", "sha256:second-digest")
+
+		store = &v1alpha1.Store{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "some-store",
+			},
+			Spec: v1alpha1.StoreSpec{
+				Sources: []v1alpha1.StoreImage{
+					{Image: "some/first"},
+					{Image: "some/second"},
+				},
+			},
+		}
+	)
+
+	it.Before(func() {
+		require.NoError(t, firstImage.SetLabel(cnb.BuildpackLayersLabel, `{
+  "org.two": {"2.0.0": {"layerDiffID": "sha256:two", "api": "0.2", "stacks": [{"id": "io.buildpacks.stacks.bionic"}]}},
+  "org.one": {"1.0.0": {"layerDiffID": "sha256:one-first"}}
+}`))
+		require.NoError(t, secondImage.SetLabel(cnb.BuildpackLayersLabel, `{
+  "org.one": {
+    "1.0.0": {"layerDiffID": "sha256:one-second"},
+    "1.1.0": {"layerDiffID": "sha256:one-newer"}
+  }
+}`))
+
+		fakeFactory.NewRemoteStub = func(image string, _ registry.SecretRef) (registry.RemoteImage, error) {
+			if image == "some/first" {
+				return firstImage, nil
+			}
+			return secondImage, nil
+		}
+	})
+
+	when("#Read", func() {
+		it("lists the buildpacks of every source sorted by id and version", func() {
+			buildpacks, err := subject.Read(store)
+			require.NoError(t, err)
+
+			assert.Equal(t, []v1alpha1.StoreBuildpack{
+				{
+					ID:         "org.one",
+					Version:    "1.0.0",
+					StoreImage: v1alpha1.StoreImage{Image: "index.docker.io/some/first@sha256:first-digest"},
+					DiffID:     "sha256:one-first",
+				},
+				{
+					ID:         "org.one",
+					Version:    "1.1.0",
+					StoreImage: v1alpha1.StoreImage{Image: "index.docker.io/some/second@sha256:second-digest"},
+					DiffID:     "sha256:one-newer",
+				},
+				{
+					ID:         "org.two",
+					Version:    "2.0.0",
+					StoreImage: v1alpha1.StoreImage{Image: "index.docker.io/some/first@sha256:first-digest"},
+					DiffID:     "sha256:two",
+					API:        "0.2",
+					Stacks:     []v1alpha1.BuildpackStack{{ID: "io.buildpacks.stacks.bionic"}},
+				},
+			}, buildpacks)
+		})
+
+		it("pulls the sources with the secrets of the referenced service account", func() {
+			store.Spec.ServiceAccountRef = &corev1.ObjectReference{Name: "some-sa", Namespace: "some-namespace"}
+
+			_, err := subject.Read(store)
+			require.NoError(t, err)
+
+			require.Equal(t, 2, fakeFactory.NewRemoteCallCount())
+			for i := 0; i < 2; i++ {
+				_, secretRef := fakeFactory.NewRemoteArgsForCall(i)
+				assert.Equal(t, registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}, secretRef)
+			}
+		})
+
+		it("errors when a source is not a buildpackage", func() {
+			require.NoError(t, secondImage.SetLabel(cnb.BuildpackLayersLabel, ""))
+
+			_, err := subject.Read(store)
+			assert.EqualError(t, err, "store image some/second is missing label io.buildpacks.buildpack.layers")
+		})
+	})
+}
@@ -0,0 +1,159 @@
+package conversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Convertible is implemented by every non hub version of a kind.
+type Convertible interface {
+	runtime.Object
+	ConvertTo(ctx context.Context, hub runtime.Object) error
+	ConvertFrom(ctx context.Context, hub runtime.Object) error
+}
+
+// Kind describes how a single kind is converted. All conversions go through
+// the Hub so each additional version only needs to convert to and from it.
+type Kind struct {
+	Hub    runtime.Object
+	Spokes map[string]Convertible
+}
+
+type Converter struct {
+	Logger     *zap.SugaredLogger
+	Group      string
+	HubVersion string
+	Kinds      map[string]Kind
+}
+
+func (c *Converter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+		http.Error(w, "invalid Content-Type, want `application/json`", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var review apiextensionsv1beta1.ConversionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(w, fmt.Sprintf("could not decode body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if review.Request == nil {
+		http.Error(w, "conversion review has no request", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = c.Convert(r.Context(), review.Request)
+	review.Request = nil
+
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		http.Error(w, fmt.Sprintf("could not encode response: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (c *Converter) Convert(ctx context.Context, req *apiextensionsv1beta1.ConversionRequest) *apiextensionsv1beta1.ConversionResponse {
+	response := &apiextensionsv1beta1.ConversionResponse{
+		UID: req.UID,
+	}
+
+	desired, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		return failed(response, err)
+	}
+
+	for _, obj := range req.Objects {
+		converted, err := c.convert(ctx, obj, desired)
+		if err != nil {
+			c.Logger.Errorw("Failed to convert object", zap.String("desiredAPIVersion", req.DesiredAPIVersion), zap.Error(err))
+			return failed(response, err)
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, converted)
+	}
+
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+func (c *Converter) convert(ctx context.Context, in runtime.RawExtension, desired schema.GroupVersion) (runtime.RawExtension, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(in.Raw, &typeMeta); err != nil {
+		return runtime.RawExtension{}, fmt.Errorf("could not decode object type: %v", err)
+	}
+
+	source, err := schema.ParseGroupVersion(typeMeta.APIVersion)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+
+	if source.Group != c.Group || desired.Group != c.Group {
+		return runtime.RawExtension{}, fmt.Errorf("unexpected conversion from %s to %s", source, desired)
+	}
+
+	kind, ok := c.Kinds[typeMeta.Kind]
+	if !ok {
+		return runtime.RawExtension{}, fmt.Errorf("no conversion registered for kind %s", typeMeta.Kind)
+	}
+
+	hub := kind.Hub.DeepCopyObject()
+	if source.Version == c.HubVersion {
+		if err := json.Unmarshal(in.Raw, hub); err != nil {
+			return runtime.RawExtension{}, err
+		}
+	} else {
+		spoke, err := kind.spoke(source.Version)
+		if err != nil {
+			return runtime.RawExtension{}, err
+		}
+		if err := json.Unmarshal(in.Raw, spoke); err != nil {
+			return runtime.RawExtension{}, err
+		}
+		if err := spoke.ConvertTo(ctx, hub); err != nil {
+			return runtime.RawExtension{}, err
+		}
+	}
+
+	out := hub
+	if desired.Version != c.HubVersion {
+		spoke, err := kind.spoke(desired.Version)
+		if err != nil {
+			return runtime.RawExtension{}, err
+		}
+		if err := spoke.ConvertFrom(ctx, hub); err != nil {
+			return runtime.RawExtension{}, err
+		}
+		out = spoke
+	}
+	out.GetObjectKind().SetGroupVersionKind(desired.WithKind(typeMeta.Kind))
+
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	return runtime.RawExtension{Raw: raw}, nil
+}
+
+func (k Kind) spoke(version string) (Convertible, error) {
+	spoke, ok := k.Spokes[version]
+	if !ok {
+		return nil, fmt.Errorf("unsupported version %s", version)
+	}
+	return spoke.DeepCopyObject().(Convertible), nil
+}
+
+func failed(response *apiextensionsv1beta1.ConversionResponse, err error) *apiextensionsv1beta1.ConversionResponse {
+	response.ConvertedObjects = nil
+	response.Result = metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+	return response
+}
@@ -0,0 +1,126 @@
+package conversion_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha2"
+	"github.com/pivotal/kpack/pkg/conversion"
+)
+
+func TestConverter(t *testing.T) {
+	spec.Run(t, "Converter", testConverter)
+}
+
+func testConverter(t *testing.T, when spec.G, it spec.S) {
+	converter := &conversion.Converter{
+		Logger:     zap.NewNop().Sugar(),
+		Group:      "build.pivotal.io",
+		HubVersion: "v1alpha1",
+		Kinds: map[string]conversion.Kind{
+			"Builder": {
+				Hub:    &v1alpha1.Builder{},
+				Spokes: map[string]conversion.Convertible{"v1alpha2": &v1alpha2.Builder{}},
+			},
+		},
+	}
+
+	review := func(desiredAPIVersion string, objects ...string) *apiextensionsv1beta1.ConversionResponse {
+		t.Helper()
+		request := apiextensionsv1beta1.ConversionReview{
+			Request: &apiextensionsv1beta1.ConversionRequest{
+				UID:               "some-uid",
+				DesiredAPIVersion: desiredAPIVersion,
+			},
+		}
+		for _, o := range objects {
+			request.Request.Objects = append(request.Request.Objects, runtime.RawExtension{Raw: []byte(o)})
+		}
+
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+
+		converter.ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		response := apiextensionsv1beta1.ConversionReview{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.NotNil(t, response.Response)
+		assert.Equal(t, "some-uid", string(response.Response.UID))
+		return response.Response
+	}
+
+	const v1alpha1Builder = `{
+  "apiVersion": "build.pivotal.io/v1alpha1",
+  "kind": "Builder",
+  "metadata": {"name": "some-builder", "namespace": "some-namespace"},
+  "spec": {"image": "some/builder", "imagePullSecrets": [{"name": "secret-1"}, {"name": "secret-2"}]},
+  "status": {"builderMetadata": [{"key": "io.buildpack", "version": "1.0"}], "latestImage": "some/builder@sha256:123"}
+}`
+
+	const v1alpha2Builder = `{
+  "apiVersion": "build.pivotal.io/v1alpha2",
+  "kind": "Builder",
+  "metadata": {"name": "some-builder", "namespace": "some-namespace"},
+  "spec": {"image": "some/builder", "imagePullSecrets": [{"name": "secret-1"}, {"name": "secret-2"}]},
+  "status": {"builderMetadata": [{"id": "io.buildpack", "version": "1.0"}], "latestImage": "some/builder@sha256:123"}
+}`
+
+	it("converts v1alpha1 to v1alpha2", func() {
+		response := review("build.pivotal.io/v1alpha2", v1alpha1Builder)
+
+		assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+		require.Len(t, response.ConvertedObjects, 1)
+
+		builder := &v1alpha2.Builder{}
+		require.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, builder))
+		assert.Equal(t, "build.pivotal.io/v1alpha2", builder.APIVersion)
+		assert.Equal(t, "Builder", builder.Kind)
+		assert.Equal(t, "some-builder", builder.Name)
+		assert.Equal(t, v1alpha2.BuildpackMetadataList{{ID: "io.buildpack", Version: "1.0"}}, builder.Status.BuilderMetadata)
+		assert.Len(t, builder.Spec.ImagePullSecrets, 2)
+	})
+
+	it("converts v1alpha2 to v1alpha1", func() {
+		response := review("build.pivotal.io/v1alpha1", v1alpha2Builder)
+
+		assert.Equal(t, metav1.StatusSuccess, response.Result.Status)
+		require.Len(t, response.ConvertedObjects, 1)
+
+		builder := &v1alpha1.Builder{}
+		require.NoError(t, json.Unmarshal(response.ConvertedObjects[0].Raw, builder))
+		assert.Equal(t, "build.pivotal.io/v1alpha1", builder.APIVersion)
+		assert.Equal(t, v1alpha1.BuildpackMetadataList{{ID: "io.buildpack", Version: "1.0"}}, builder.Status.BuilderMetadata)
+	})
+
+	it("fails on unknown kinds", func() {
+		response := review("build.pivotal.io/v1alpha2", `{"apiVersion": "build.pivotal.io/v1alpha1", "kind": "Unknown"}`)
+
+		assert.Equal(t, metav1.StatusFailure, response.Result.Status)
+		assert.Equal(t, "no conversion registered for kind Unknown", response.Result.Message)
+		assert.Empty(t, response.ConvertedObjects)
+	})
+
+	it("fails on unknown versions", func() {
+		response := review("build.pivotal.io/v1beta1", v1alpha1Builder)
+
+		assert.Equal(t, metav1.StatusFailure, response.Result.Status)
+		assert.Equal(t, "unsupported version v1beta1", response.Result.Message)
+	})
+}
@@ -0,0 +1,127 @@
+package conversion
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// keys of the certificate secret maintained by the admission webhook
+const (
+	serverKey  = "server-key.pem"
+	serverCert = "server-cert.pem"
+	caCert     = "ca-cert.pem"
+)
+
+// Webhook serves the Converter over TLS and registers itself as the
+// conversion webhook of CRDs. It reuses the certificates the admission
+// webhook generates in SecretName.
+type Webhook struct {
+	Client           kubernetes.Interface
+	ExtensionsClient apiextensionsclientset.Interface
+	Converter        http.Handler
+	Logger           *zap.SugaredLogger
+
+	Namespace   string
+	ServiceName string
+	SecretName  string
+	Path        string
+	Port        int32
+	CRDs        []string
+}
+
+func (w *Webhook) Run(stop <-chan struct{}) error {
+	secret, err := w.waitForCertificates(stop)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[serverCert], secret.Data[serverKey])
+	if err != nil {
+		return err
+	}
+
+	for _, crd := range w.CRDs {
+		if err := w.register(crd, secret.Data[caCert]); err != nil {
+			return err
+		}
+	}
+	w.Logger.Info("Successfully registered conversion webhook")
+
+	mux := http.NewServeMux()
+	mux.Handle(w.Path, w.Converter)
+
+	server := &http.Server{
+		Handler: mux,
+		Addr:    fmt.Sprintf(":%d", w.Port),
+		TLSConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		},
+	}
+
+	serverErr := make(chan struct{})
+	go func() {
+		if err := server.ListenAndServeTLS("", ""); err != nil {
+			w.Logger.Errorw("ListenAndServeTLS for conversion webhook returned error", zap.Error(err))
+			close(serverErr)
+		}
+	}()
+
+	select {
+	case <-stop:
+		return server.Close()
+	case <-serverErr:
+		return errors.New("conversion webhook server bootstrap failed")
+	}
+}
+
+func (w *Webhook) waitForCertificates(stop <-chan struct{}) (*corev1.Secret, error) {
+	var secret *corev1.Secret
+	err := wait.PollImmediateUntil(time.Second, func() (bool, error) {
+		var err error
+		secret, err = w.Client.CoreV1().Secrets(w.Namespace).Get(w.SecretName, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		return true, nil
+	}, stop)
+	return secret, err
+}
+
+func (w *Webhook) register(name string, caBundle []byte) error {
+	crd, err := w.ExtensionsClient.ApiextensionsV1beta1().CustomResourceDefinitions().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	crd = crd.DeepCopy()
+	crd.Spec.Conversion = &apiextensionsv1beta1.CustomResourceConversion{
+		Strategy: apiextensionsv1beta1.WebhookConverter,
+		WebhookClientConfig: &apiextensionsv1beta1.WebhookClientConfig{
+			Service: &apiextensionsv1beta1.ServiceReference{
+				Namespace: w.Namespace,
+				Name:      w.ServiceName,
+				Path:      &w.Path,
+				Port:      &w.Port,
+			},
+			CABundle: caBundle,
+		},
+		ConversionReviewVersions: []string{"v1beta1"},
+	}
+
+	_, err = w.ExtensionsClient.ApiextensionsV1beta1().CustomResourceDefinitions().Update(crd)
+	return err
+}
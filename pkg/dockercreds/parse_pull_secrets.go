@@ -5,9 +5,55 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
+// ParseDockerPullSecrets parses the docker config files in path and in each of
+// its subdirectories. Multiple pull secrets are mounted one per subdirectory.
 func ParseDockerPullSecrets(path string) (DockerCreds, error) {
+	creds, err := parseDockerPullSecret(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return creds, nil
+		}
+		return nil, err
+	}
+
+	for _, e := range entries {
+		// skip the hidden timestamped directories written by kubernetes volume projection
+		if strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+
+		dir := filepath.Join(path, e.Name())
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			continue
+		}
+
+		secretCreds, err := parseDockerPullSecret(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		creds, err = creds.Append(secretCreds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+func parseDockerPullSecret(path string) (DockerCreds, error) {
 	dockerCfg, err := parseDockerCfg(filepath.Join(path, ".dockercfg"))
 	if err != nil {
 		return nil, err
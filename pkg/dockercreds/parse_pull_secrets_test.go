@@ -104,6 +104,58 @@ func parsePullSecrets(t *testing.T, when spec.G, it spec.S) {
 		creds, err := ParseDockerPullSecrets(testPullSecretsDir)
 		require.NoError(t, err)
 
+		expectedCreds := DockerCreds{
+			"https://index.docker.io/v1/": entry{
+				Auth:     "dGVzdHVzZXJuYW1lOnRlc3RwYXNzd29yZHNpbGxpbmVzcwo=",
+				Username: "testdockerhub",
+				Password: "testdockerhubusername",
+			},
+			"gcr.io": entry{
+				Auth:     "dGVzdHVzZXJuYW1lOnRlc3RwYXNzd29yZHRoYXR3aWxsbm90d29yawo=",
+				Username: "testusername",
+				Password: "testpassword",
+			},
+		}
+		require.Equal(t, expectedCreds, creds)
+	})
+	it("parses the pull secrets in each subdirectory", func() {
+		require.NoError(t, os.Mkdir(filepath.Join(testPullSecretsDir, "0"), os.ModePerm))
+		err := ioutil.WriteFile(filepath.Join(testPullSecretsDir, "0", ".dockerconfigjson"), []byte(`{
+  "auths": {
+    "https://index.docker.io/v1/": {
+      "auth": "dGVzdHVzZXJuYW1lOnRlc3RwYXNzd29yZHNpbGxpbmVzcwo=",
+      "username": "testdockerhub",
+      "password": "testdockerhubusername"
+    }
+  }
+}`,
+		), os.ModePerm)
+		require.NoError(t, err)
+
+		require.NoError(t, os.Mkdir(filepath.Join(testPullSecretsDir, "1"), os.ModePerm))
+		err = ioutil.WriteFile(filepath.Join(testPullSecretsDir, "1", ".dockercfg"), []byte(`{
+  "gcr.io": {
+    "auth": "dGVzdHVzZXJuYW1lOnRlc3RwYXNzd29yZHRoYXR3aWxsbm90d29yawo=",
+    "username": "testusername",
+    "password": "testpassword"
+  }
+}`,
+		), os.ModePerm)
+		require.NoError(t, err)
+
+		require.NoError(t, os.Mkdir(filepath.Join(testPullSecretsDir, "..2019_10_19_15_49_34.000000000"), os.ModePerm))
+		err = ioutil.WriteFile(filepath.Join(testPullSecretsDir, "..2019_10_19_15_49_34.000000000", ".dockercfg"), []byte(`{
+  "ignored.io": {
+    "username": "ignored",
+    "password": "ignored"
+  }
+}`,
+		), os.ModePerm)
+		require.NoError(t, err)
+
+		creds, err := ParseDockerPullSecrets(testPullSecretsDir)
+		require.NoError(t, err)
+
 		expectedCreds := DockerCreds{
 			"https://index.docker.io/v1/": entry{
 				Auth:     "dGVzdHVzZXJuYW1lOnRlc3RwYXNzd29yZHNpbGxpbmVzcwo=",
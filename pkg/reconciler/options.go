@@ -11,10 +11,11 @@ import (
 type Options struct {
 	Logger *zap.SugaredLogger
 
-	Client                  versioned.Interface
-	ResyncPeriod            time.Duration
-	SourcePollingFrequency  time.Duration
-	BuilderPollingFrequency time.Duration
+	Client                     versioned.Interface
+	ResyncPeriod               time.Duration
+	SourcePollingFrequency     time.Duration
+	BuilderPollingFrequency    time.Duration
+	BuildQueuePollingFrequency time.Duration
 }
 
 func (o Options) TrackerResyncPeriod() time.Duration {
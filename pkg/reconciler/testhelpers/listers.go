@@ -66,6 +66,22 @@ func (l *Listers) GetClusterBuilderLister() v1alpha1Listers.ClusterBuilderLister
 	return v1alpha1Listers.NewClusterBuilderLister(l.indexerFor(&v1alpha1.ClusterBuilder{}))
 }
 
+func (l *Listers) GetCustomBuilderLister() v1alpha1Listers.CustomBuilderLister {
+	return v1alpha1Listers.NewCustomBuilderLister(l.indexerFor(&v1alpha1.CustomBuilder{}))
+}
+
+func (l *Listers) GetStackLister() v1alpha1Listers.StackLister {
+	return v1alpha1Listers.NewStackLister(l.indexerFor(&v1alpha1.Stack{}))
+}
+
+func (l *Listers) GetStoreLister() v1alpha1Listers.StoreLister {
+	return v1alpha1Listers.NewStoreLister(l.indexerFor(&v1alpha1.Store{}))
+}
+
+func (l *Listers) GetImagePromotionLister() v1alpha1Listers.ImagePromotionLister {
+	return v1alpha1Listers.NewImagePromotionLister(l.indexerFor(&v1alpha1.ImagePromotion{}))
+}
+
 func (l *Listers) GetSourceResolverLister() v1alpha1Listers.SourceResolverLister {
 	return v1alpha1Listers.NewSourceResolverLister(l.indexerFor(&v1alpha1.SourceResolver{}))
 }
@@ -2,10 +2,14 @@ package build
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1Informers "k8s.io/client-go/informers/core/v1"
 	k8sclient "k8s.io/client-go/kubernetes"
@@ -21,6 +25,7 @@ import (
 	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/cnb"
 	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
 )
 
 const (
@@ -33,11 +38,37 @@ type MetadataRetriever interface {
 	GetBuiltImage(repoName *v1alpha1.Build) (cnb.BuiltImage, error)
 }
 
+//go:generate counterfeiter . ImageLabeler
+type ImageLabeler interface {
+	Label(build *v1alpha1.Build, labels map[string]string) (cnb.BuiltImage, error)
+}
+
+//go:generate counterfeiter . ImageSigner
+type ImageSigner interface {
+	Sign(*v1alpha1.Build) (v1alpha1.BuildSignature, error)
+}
+
 type PodGenerator interface {
 	Generate(*v1alpha1.Build) (*corev1.Pod, error)
+	GenerateForPlatform(build *v1alpha1.Build, platform string) (*corev1.Pod, error)
+}
+
+//go:generate counterfeiter . IndexWriter
+type IndexWriter interface {
+	Write(tags []string, images []registry.PlatformImage, secretRef registry.SecretRef) (string, error)
+}
+
+//go:generate counterfeiter . ImagePromoter
+type ImagePromoter interface {
+	Promote(source, tag string, secretRef registry.SecretRef) (string, error)
 }
 
-func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, informer v1alpha1informer.BuildInformer, podInformer corev1Informers.PodInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator, imageRebaser cnb.ImageRebaser) *controller.Impl {
+//go:generate counterfeiter . Enqueuer
+type Enqueuer interface {
+	Enqueue(*v1alpha1.Build) error
+}
+
+func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, informer v1alpha1informer.BuildInformer, podInformer corev1Informers.PodInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator, imageRebaser cnb.ImageRebaser, imageLabeler ImageLabeler, indexWriter IndexWriter, imageSigner ImageSigner, imagePromoter ImagePromoter, limits Limits) *controller.Impl {
 	c := &Reconciler{
 		Client:            opt.Client,
 		K8sClient:         k8sClient,
@@ -46,10 +77,20 @@ func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, inform
 		PodLister:         podInformer.Lister(),
 		PodGenerator:      podGenerator,
 		ImageRebaser:      imageRebaser,
+		ImageLabeler:      imageLabeler,
+		IndexWriter:       indexWriter,
+		ImageSigner:       imageSigner,
+		ImagePromoter:     imagePromoter,
+		Limits:            limits,
 	}
 
 	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
 
+	c.Enqueuer = &workQueueEnqueuer{
+		enqueueAfter: impl.EnqueueAfter,
+		delay:        opt.BuildQueuePollingFrequency,
+	}
+
 	informer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
 
 	podInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -68,6 +109,12 @@ type Reconciler struct {
 	PodLister         v1Listers.PodLister
 	PodGenerator      PodGenerator
 	ImageRebaser      cnb.ImageRebaser
+	ImageLabeler      ImageLabeler
+	IndexWriter       IndexWriter
+	ImageSigner       ImageSigner
+	ImagePromoter     ImagePromoter
+	Limits            Limits
+	Enqueuer          Enqueuer
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -85,7 +132,13 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 	build = build.DeepCopy()
 
 	if build.Finished() {
-		return nil
+		if err := c.reconcileMirrorTags(build); err != nil {
+			return err
+		}
+		if err := c.reconcileSignature(build); err != nil {
+			return err
+		}
+		return c.updateStatus(build)
 	}
 
 	if build.Rebasable() {
@@ -108,6 +161,10 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 		build.Status.BuildMetadata = buildMetadataFromBuiltImage(image)
 		build.Status.LatestImage = image.Identifier
 		build.Status.RunImage = image.RunImage
+		build.Status.PushedTags = image.Tags
+		if err := c.reconcileBOM(build, image.BOM); err != nil {
+			return err
+		}
 		build.Status.Conditions = duckv1alpha1.Conditions{
 			{
 				Type:               duckv1alpha1.ConditionSucceeded,
@@ -116,33 +173,236 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 			},
 		}
 	} else {
-		pod, err := c.reconcileBuildPod(build)
+		pending, err := c.pendingBuild(build)
 		if err != nil {
 			return err
 		}
 
-		if build.MetadataReady(pod) {
-			image, err := c.MetadataRetriever.GetBuiltImage(build)
+		if pending != nil {
+			build.Status.Conditions = pending.conditions()
+			build.Status.QueuePosition = pending.position
+			build.Status.ObservedGeneration = build.Generation
+			if err := c.updateStatus(build); err != nil {
+				return err
+			}
+			return c.Enqueuer.Enqueue(build)
+		}
+
+		if build.MultiPlatform() {
+			if err := c.reconcilePlatformBuilds(build); err != nil {
+				return err
+			}
+		} else {
+			pod, err := c.reconcileBuildPod(build)
 			if err != nil {
 				return err
 			}
 
-			build.Status.BuildMetadata = buildMetadataFromBuiltImage(image)
-			build.Status.LatestImage = image.Identifier
-			build.Status.RunImage = image.RunImage
-		}
+			if build.MetadataReady(pod) {
+				image, err := c.MetadataRetriever.GetBuiltImage(build)
+				if err != nil {
+					return err
+				}
+
+				build.Status.BuildMetadata = buildMetadataFromBuiltImage(image)
+				build.Status.LatestImage = image.Identifier
+				build.Status.RunImage = image.RunImage
+				build.Status.PushedTags = image.Tags
+				if err := c.reconcileBOM(build, image.BOM); err != nil {
+					return err
+				}
+			}
 
-		build.Status.PodName = pod.Name
-		build.Status.StepStates = stepStates(pod)
-		build.Status.StepsCompleted = stepCompleted(pod)
-		build.Status.Conditions = conditionForPod(pod)
+			build.Status.PodName = pod.Name
+			build.Status.StepStates = stepStates(pod)
+			build.Status.StepsCompleted = stepCompleted(pod)
+			build.Status.StartTime = startTime(pod)
+			build.Status.CompletionTime = completionTime(pod)
+			build.Status.Conditions = conditionForPod(pod)
+			build.Status.CacheSize = cacheSize(pod)
+		}
+		build.Status.QueuePosition = 0
 	}
 
 	build.Status.ObservedGeneration = build.Generation
 
+	if build.IsSuccess() && !build.MultiPlatform() && build.Status.ImageLabels == nil {
+		labels := build.ImageLabels()
+		image, err := c.ImageLabeler.Label(build, labels)
+		if err != nil {
+			// the exported image stays in status so that labeling is retried
+			// from it while the build is still reported as running
+			build.Status.Conditions = labelingConditions(err)
+			if err := c.updateStatus(build); err != nil {
+				return err
+			}
+			return err
+		}
+		if image.Identifier != build.Status.LatestImage {
+			build.Status.ExportedImage = build.Status.LatestImage
+		}
+		build.Status.LatestImage = image.Identifier
+		build.Status.PushedTags = image.Tags
+		build.Status.ImageLabels = labels
+	}
+
+	if err := c.reconcileMirrorTags(build); err != nil {
+		return err
+	}
+
+	if err := c.reconcileSignature(build); err != nil {
+		return err
+	}
+
 	return c.updateStatus(build)
 }
 
+// reconcileMirrorTags copies the image of a succeeded build to its tags in
+// other registries, which the lifecycle cannot write to, and records them in
+// the pushed tags. The image is already pushed, so a failure to copy is
+// reported in the Mirrored condition and retried instead of failing the build.
+func (c *Reconciler) reconcileMirrorTags(build *v1alpha1.Build) error {
+	if !build.IsSuccess() || build.MultiPlatform() {
+		return nil
+	}
+
+	pushed := map[string]bool{}
+	for _, pushedTag := range build.Status.PushedTags {
+		pushed[pushedTag.Tag] = true
+	}
+
+	var pending []string
+	for _, tag := range build.MirrorTags() {
+		if !pushed[tag] {
+			pending = append(pending, tag)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	for _, tag := range pending {
+		pushedTag, err := c.mirrorTag(build, tag)
+		if err == nil {
+			build.Status.PushedTags = append(build.Status.PushedTags, pushedTag)
+			continue
+		}
+
+		build.Status.Conditions = withCondition(build.Status.Conditions, duckv1alpha1.Condition{
+			Type:               v1alpha1.BuildConditionMirrored,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+			Message:            fmt.Sprintf("failed to copy image to %s: %s", tag, err),
+		})
+		if err := c.updateStatus(build); err != nil {
+			return err
+		}
+		return err
+	}
+
+	build.Status.Conditions = withCondition(build.Status.Conditions, duckv1alpha1.Condition{
+		Type:               v1alpha1.BuildConditionMirrored,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+	})
+	return nil
+}
+
+func (c *Reconciler) mirrorTag(build *v1alpha1.Build, tag string) (v1alpha1.PushedTag, error) {
+	identifier, err := c.ImagePromoter.Promote(build.Status.LatestImage, tag, registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccount,
+		Namespace:      build.Namespace,
+	})
+	if err != nil {
+		return v1alpha1.PushedTag{}, err
+	}
+
+	digest, err := name.NewDigest(identifier, name.WeakValidation)
+	if err != nil {
+		return v1alpha1.PushedTag{}, err
+	}
+	return v1alpha1.PushedTag{Tag: tag, Digest: digest.DigestStr()}, nil
+}
+
+// reconcileSignature signs the image of a succeeded build once. The image is
+// already pushed, so a failure to sign is reported in the Signed condition and
+// retried instead of failing the build.
+func (c *Reconciler) reconcileSignature(build *v1alpha1.Build) error {
+	if !build.IsSuccess() || build.Spec.SigningSecret == "" || build.Status.Signature != nil {
+		return nil
+	}
+
+	signature, err := c.ImageSigner.Sign(build)
+	if err != nil {
+		build.Status.Conditions = withCondition(build.Status.Conditions, duckv1alpha1.Condition{
+			Type:               v1alpha1.BuildConditionSigned,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+			Message:            fmt.Sprintf("failed to sign image: %s", err),
+		})
+		if err := c.updateStatus(build); err != nil {
+			return err
+		}
+		return err
+	}
+
+	build.Status.Signature = &signature
+	build.Status.Conditions = withCondition(build.Status.Conditions, duckv1alpha1.Condition{
+		Type:               v1alpha1.BuildConditionSigned,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+	})
+	return nil
+}
+
+// labelingConditions keeps a build whose image could not be labeled running so
+// that labeling is retried.
+func labelingConditions(err error) duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionUnknown,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+			Message:            fmt.Sprintf("failed to label image: %s", err),
+		},
+	}
+}
+
+func withCondition(conditions duckv1alpha1.Conditions, condition duckv1alpha1.Condition) duckv1alpha1.Conditions {
+	updated := make(duckv1alpha1.Conditions, 0, len(conditions)+1)
+	for _, c := range conditions {
+		if c.Type != condition.Type {
+			updated = append(updated, c)
+		}
+	}
+	return append(updated, condition)
+}
+
+// reconcileBOM stores the full bill of materials in a ConfigMap owned by the
+// build and lists a bounded part of it in the build status.
+func (c *Reconciler) reconcileBOM(build *v1alpha1.Build, bom []v1alpha1.BOMEntry) error {
+	build.Status.BOM = v1alpha1.StatusBOM(bom)
+	if len(bom) == 0 {
+		return nil
+	}
+
+	configMap, err := build.BOMConfigMap(bom)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.K8sClient.CoreV1().ConfigMaps(build.Namespace).Create(configMap)
+	if k8s_errors.IsAlreadyExists(err) {
+		_, err = c.K8sClient.CoreV1().ConfigMaps(build.Namespace).Update(configMap)
+	}
+	if err != nil {
+		return err
+	}
+
+	build.Status.BOMConfigMap = configMap.Name
+	return nil
+}
+
 func (c *Reconciler) reconcileBuildPod(build *v1alpha1.Build) (*corev1.Pod, error) {
 	pod, err := c.PodLister.Pods(build.Namespace).Get(build.PodName())
 	if err != nil && !k8s_errors.IsNotFound(err) {
@@ -158,6 +418,183 @@ func (c *Reconciler) reconcileBuildPod(build *v1alpha1.Build) (*corev1.Pod, erro
 	return pod, nil
 }
 
+// reconcilePlatformBuilds runs a pod per platform of a multi-platform build and
+// pushes an image index of the platform images to the tags of the build once
+// all of them are built. The build fails as soon as one of the pods fails.
+func (c *Reconciler) reconcilePlatformBuilds(build *v1alpha1.Build) error {
+	statuses := make([]v1alpha1.BuildPlatformStatus, 0, len(build.Spec.Platforms))
+	pods := make([]*corev1.Pod, 0, len(build.Spec.Platforms))
+	for _, platform := range build.Spec.Platforms {
+		pod, err := c.reconcilePlatformPod(build, platform)
+		if err != nil {
+			return err
+		}
+
+		status := build.PlatformStatus(platform)
+		if pod.Status.Phase == corev1.PodSucceeded && status.LatestImage == "" {
+			image, err := c.MetadataRetriever.GetBuiltImage(build.PlatformBuild(platform))
+			if err != nil {
+				return err
+			}
+
+			status.LatestImage = image.Identifier
+			if len(statuses) == 0 {
+				build.Status.BuildMetadata = buildMetadataFromBuiltImage(image)
+				build.Status.RunImage = image.RunImage
+				if err := c.reconcileBOM(build, image.BOM); err != nil {
+					return err
+				}
+			}
+		}
+
+		status.PodName = pod.Name
+		status.StepsCompleted = stepCompleted(pod)
+		status.Conditions = conditionForPod(pod)
+		statuses = append(statuses, status)
+		pods = append(pods, pod)
+	}
+
+	build.Status.Platforms = statuses
+	build.Status.StartTime = platformStartTime(pods)
+	build.Status.Conditions = conditionForPlatforms(statuses)
+	if !build.Finished() {
+		build.Status.CompletionTime = nil
+		return nil
+	}
+
+	build.Status.CompletionTime = platformCompletionTime(pods)
+	if build.IsFailure() {
+		return nil
+	}
+
+	if build.Status.ImageLabels == nil {
+		labels := build.ImageLabels()
+		for i := range statuses {
+			if statuses[i].ExportedImage != "" {
+				continue
+			}
+
+			image, err := c.ImageLabeler.Label(build.PlatformBuild(statuses[i].Platform), labels)
+			if err != nil {
+				build.Status.Conditions = labelingConditions(err)
+				build.Status.ObservedGeneration = build.Generation
+				if err := c.updateStatus(build); err != nil {
+					return err
+				}
+				return err
+			}
+			if image.Identifier != statuses[i].LatestImage {
+				statuses[i].ExportedImage = statuses[i].LatestImage
+			}
+			statuses[i].LatestImage = image.Identifier
+		}
+		build.Status.ImageLabels = labels
+	}
+
+	images := make([]registry.PlatformImage, 0, len(statuses))
+	for _, status := range statuses {
+		images = append(images, registry.PlatformImage{Platform: status.Platform, Image: status.LatestImage})
+	}
+
+	identifier, err := c.IndexWriter.Write(build.Spec.Tags, images, registry.SecretRef{
+		ServiceAccount: build.Spec.ServiceAccount,
+		Namespace:      build.Namespace,
+	})
+	if err != nil {
+		build.Status.Conditions = duckv1alpha1.Conditions{
+			{
+				Type:               duckv1alpha1.ConditionSucceeded,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+				Message:            fmt.Sprintf("failed to push image index: %s", err),
+			},
+		}
+		build.Status.ObservedGeneration = build.Generation
+		if err := c.updateStatus(build); err != nil {
+			return err
+		}
+		return controller.NewPermanentError(err)
+	}
+
+	digest, err := name.NewDigest(identifier, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	build.Status.LatestImage = identifier
+	build.Status.PushedTags = make([]v1alpha1.PushedTag, 0, len(build.Spec.Tags))
+	for _, tag := range build.Spec.Tags {
+		build.Status.PushedTags = append(build.Status.PushedTags, v1alpha1.PushedTag{Tag: tag, Digest: digest.DigestStr()})
+	}
+	return nil
+}
+
+func (c *Reconciler) reconcilePlatformPod(build *v1alpha1.Build, platform string) (*corev1.Pod, error) {
+	pod, err := c.PodLister.Pods(build.Namespace).Get(build.PlatformPodName(platform))
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return nil, err
+	} else if k8s_errors.IsNotFound(err) {
+		podConfig, err := c.PodGenerator.GenerateForPlatform(build, platform)
+		if err != nil {
+			return nil, err
+		}
+		return c.K8sClient.CoreV1().Pods(build.Namespace).Create(podConfig)
+	}
+
+	return pod, nil
+}
+
+func conditionForPlatforms(statuses []v1alpha1.BuildPlatformStatus) duckv1alpha1.Conditions {
+	succeeded := true
+	for _, status := range statuses {
+		condition := (&duckv1alpha1.Status{Conditions: status.Conditions}).GetCondition(duckv1alpha1.ConditionSucceeded)
+		if condition.IsFalse() {
+			return duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionSucceeded,
+					Status:             corev1.ConditionFalse,
+					Reason:             condition.Reason,
+					Message:            fmt.Sprintf("%s: %s", status.Platform, condition.Message),
+					LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+				},
+			}
+		}
+		succeeded = succeeded && condition.IsTrue()
+	}
+
+	status := corev1.ConditionUnknown
+	if succeeded {
+		status = corev1.ConditionTrue
+	}
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionSucceeded,
+			Status:             status,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+func platformStartTime(pods []*corev1.Pod) *metav1.Time {
+	var earliest *metav1.Time
+	for _, pod := range pods {
+		if started := startTime(pod); started != nil && (earliest == nil || started.Before(earliest)) {
+			earliest = started
+		}
+	}
+	return earliest
+}
+
+func platformCompletionTime(pods []*corev1.Pod) *metav1.Time {
+	var latest *metav1.Time
+	for _, pod := range pods {
+		if completed := completionTime(pod); completed != nil && (latest == nil || latest.Before(completed)) {
+			latest = completed
+		}
+	}
+	return latest
+}
+
 func conditionForPod(pod *corev1.Pod) duckv1alpha1.Conditions {
 	switch pod.Status.Phase {
 	case corev1.PodSucceeded:
@@ -169,10 +606,13 @@ func conditionForPod(pod *corev1.Pod) duckv1alpha1.Conditions {
 			},
 		}
 	case corev1.PodFailed:
+		reason, message := failureForPod(pod)
 		return duckv1alpha1.Conditions{
 			{
 				Type:               duckv1alpha1.ConditionSucceeded,
 				Status:             corev1.ConditionFalse,
+				Reason:             reason,
+				Message:            message,
 				LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
 			},
 		}
@@ -188,6 +628,40 @@ func conditionForPod(pod *corev1.Pod) duckv1alpha1.Conditions {
 
 }
 
+func failureForPod(pod *corev1.Pod) (string, string) {
+	for _, s := range pod.Status.InitContainerStatuses {
+		if s.State.Terminated != nil && s.State.Terminated.ExitCode != 0 {
+			message := fmt.Sprintf("step %s failed with exit code %d", s.Name, s.State.Terminated.ExitCode)
+			if terminationMessage := strings.TrimSpace(s.State.Terminated.Message); terminationMessage != "" {
+				message = fmt.Sprintf("%s: %s", message, terminationMessage)
+			}
+			return v1alpha1.BuildStepFailed, message
+		}
+	}
+
+	return v1alpha1.BuildPodFailed, strings.TrimSpace(fmt.Sprintf("%s %s", pod.Status.Reason, pod.Status.Message))
+}
+
+// cacheSize is the cache size reported by the cache size step of a successful pod.
+func cacheSize(pod *corev1.Pod) *resource.Quantity {
+	if pod.Status.Phase != corev1.PodSucceeded {
+		return nil
+	}
+
+	for _, s := range pod.Status.InitContainerStatuses {
+		if s.Name != v1alpha1.CacheSizeContainerName || s.State.Terminated == nil {
+			continue
+		}
+
+		size, err := resource.ParseQuantity(strings.TrimSpace(s.State.Terminated.Message))
+		if err != nil {
+			return nil
+		}
+		return &size
+	}
+	return nil
+}
+
 func stepStates(pod *corev1.Pod) []corev1.ContainerState {
 	states := make([]corev1.ContainerState, 0, len(pod.Status.InitContainerStatuses))
 	for _, s := range pod.Status.InitContainerStatuses {
@@ -206,6 +680,51 @@ func stepCompleted(pod *corev1.Pod) []string {
 	return completed
 }
 
+func startTime(pod *corev1.Pod) *metav1.Time {
+	var earliest *metav1.Time
+	for _, s := range containerStatuses(pod) {
+		var startedAt metav1.Time
+		if s.State.Running != nil {
+			startedAt = s.State.Running.StartedAt
+		} else if s.State.Terminated != nil {
+			startedAt = s.State.Terminated.StartedAt
+		}
+
+		if startedAt.IsZero() {
+			continue
+		}
+
+		if earliest == nil || startedAt.Before(earliest) {
+			earliest = startedAt.DeepCopy()
+		}
+	}
+	return earliest
+}
+
+func completionTime(pod *corev1.Pod) *metav1.Time {
+	if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+		return nil
+	}
+
+	var latest *metav1.Time
+	for _, s := range containerStatuses(pod) {
+		if s.State.Terminated == nil || s.State.Terminated.FinishedAt.IsZero() {
+			continue
+		}
+
+		if latest == nil || latest.Before(&s.State.Terminated.FinishedAt) {
+			latest = s.State.Terminated.FinishedAt.DeepCopy()
+		}
+	}
+	return latest
+}
+
+func containerStatuses(pod *corev1.Pod) []corev1.ContainerStatus {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	return append(statuses, pod.Status.ContainerStatuses...)
+}
+
 func (c *Reconciler) updateStatus(desired *v1alpha1.Build) error {
 	original, err := c.Lister.Builds(desired.Namespace).Get(desired.Name)
 	if err != nil {
@@ -1,6 +1,8 @@
 package build_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/build"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/build/buildfakes"
+	"github.com/pivotal/kpack/pkg/registry"
 )
 
 //go:generate counterfeiter . MetadataRetriever
@@ -47,6 +50,12 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 
 	var (
 		fakeMetadataRetriever = &buildfakes.FakeMetadataRetriever{}
+		fakeEnqueuer          = &buildfakes.FakeEnqueuer{}
+		fakeImageLabeler      = &buildfakes.FakeImageLabeler{}
+		fakeIndexWriter       = &buildfakes.FakeIndexWriter{}
+		fakeImageSigner       = &buildfakes.FakeImageSigner{}
+		fakeImagePromoter     = &buildfakes.FakeImagePromoter{}
+		limits                build.Limits
 	)
 
 	podGenerator := &testPodGenerator{}
@@ -69,6 +78,12 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 				PodLister:         listers.GetPodLister(),
 				MetadataRetriever: fakeMetadataRetriever,
 				PodGenerator:      podGenerator,
+				ImageLabeler:      fakeImageLabeler,
+				IndexWriter:       fakeIndexWriter,
+				ImageSigner:       fakeImageSigner,
+				ImagePromoter:     fakeImagePromoter,
+				Limits:            limits,
+				Enqueuer:          fakeEnqueuer,
 			}
 
 			rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
@@ -276,6 +291,386 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		when("build limits are configured", func() {
+			otherBuild := func(namespace, name string, started bool, created time.Time) *v1alpha1.Build {
+				b := &v1alpha1.Build{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              name,
+						Namespace:         namespace,
+						CreationTimestamp: metav1.NewTime(created),
+					},
+				}
+				if started {
+					b.Status.PodName = b.PodName()
+				}
+				return b
+			}
+
+			runningPod := func(namespace, name string) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+					},
+				}
+			}
+
+			pendingStatus := func(reason string, position int) v1alpha1.BuildStatus {
+				return v1alpha1.BuildStatus{
+					Status: duckv1alpha1.Status{
+						ObservedGeneration: originalGeneration,
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionSucceeded,
+								Status: corev1.ConditionUnknown,
+							},
+							{
+								Type:    v1alpha1.BuildConditionPending,
+								Status:  corev1.ConditionTrue,
+								Reason:  reason,
+								Message: fmt.Sprintf("waiting to start at queue position %d", position),
+							},
+						},
+					},
+					QueuePosition: position,
+				}
+			}
+
+			it("keeps the build pending while the maximum number of builds are running", func() {
+				limits.MaxRunning = 1
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						otherBuild("other-namespace", "running-build", true, time.Now().Add(-time.Hour)),
+						runningPod("other-namespace", "running-build-build-pod"),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     pendingStatus("MaxRunningBuilds", 1),
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+				assert.Equal(t, build.Name, fakeEnqueuer.EnqueueArgsForCall(0).Name)
+			})
+
+			it("reports the position behind builds queued earlier", func() {
+				limits.MaxRunning = 1
+				build.CreationTimestamp = metav1.NewTime(time.Now())
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						otherBuild("other-namespace", "running-build", true, time.Now().Add(-time.Hour)),
+						runningPod("other-namespace", "running-build-build-pod"),
+						otherBuild("other-namespace", "queued-build", false, time.Now().Add(-time.Minute)),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     pendingStatus("MaxRunningBuilds", 2),
+							},
+						},
+					},
+				})
+			})
+
+			it("only counts running builds in the same namespace against the namespace limit", func() {
+				limits.MaxRunningPerNamespace = 1
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						otherBuild(namespace, "running-build", true, time.Now().Add(-time.Hour)),
+						runningPod(namespace, "running-build-build-pod"),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     pendingStatus("MaxRunningBuildsPerNamespace", 1),
+							},
+						},
+					},
+				})
+			})
+
+			it("counts multi-platform builds with platform pods as running", func() {
+				limits.MaxRunning = 1
+				build.CreationTimestamp = metav1.NewTime(time.Now())
+
+				runningBuild := otherBuild("other-namespace", "running-build", false, time.Now().Add(-time.Hour))
+				runningBuild.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+				runningBuild.Status.Platforms = []v1alpha1.BuildPlatformStatus{
+					{Platform: "linux/amd64", PodName: runningBuild.PlatformPodName("linux/amd64")},
+					{Platform: "linux/arm64", PodName: runningBuild.PlatformPodName("linux/arm64")},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						runningBuild,
+						runningPod("other-namespace", runningBuild.PlatformPodName("linux/amd64")),
+						runningPod("other-namespace", runningBuild.PlatformPodName("linux/arm64")),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     pendingStatus("MaxRunningBuilds", 1),
+							},
+						},
+					},
+				})
+			})
+
+			it("does not queue a multi-platform build whose platform pods exist", func() {
+				limits.MaxRunning = 1
+				build.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+				amd64Pod, err := podGenerator.GenerateForPlatform(build, "linux/amd64")
+				require.NoError(t, err)
+				arm64Pod, err := podGenerator.GenerateForPlatform(build, "linux/arm64")
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						amd64Pod,
+						arm64Pod,
+						otherBuild("other-namespace", "running-build", true, time.Now().Add(-time.Hour)),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									Platforms: []v1alpha1.BuildPlatformStatus{
+										{
+											Platform: "linux/amd64",
+											PodName:  amd64Pod.Name,
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
+											},
+										},
+										{
+											Platform: "linux/arm64",
+											PodName:  arm64Pod.Name,
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("counts builds whose pod exists before the pod name is recorded in their status", func() {
+				limits.MaxRunning = 1
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						otherBuild("other-namespace", "starting-build", false, time.Now().Add(-time.Hour)),
+						runningPod("other-namespace", "starting-build-build-pod"),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     pendingStatus("MaxRunningBuilds", 1),
+							},
+						},
+					},
+				})
+			})
+
+			it("starts a pending build once the limit allows it", func() {
+				limits.MaxRunning = 2
+				limits.MaxRunningPerNamespace = 1
+				build.Status = pendingStatus("MaxRunningBuilds", 1)
+
+				buildPod, err := podGenerator.Generate(build)
+				require.NoError(t, err)
+
+				finishedBuild := otherBuild(namespace, "finished-build", true, time.Now().Add(-time.Hour))
+				finishedBuild.Status.Conditions = duckv1alpha1.Conditions{
+					{
+						Type:   duckv1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						finishedBuild,
+						runningPod(namespace, "finished-build-build-pod"),
+						otherBuild("other-namespace", "running-build", true, time.Now().Add(-time.Hour)),
+						runningPod("other-namespace", "running-build-build-pod"),
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			when("a builder rollout rate is configured", func() {
+				builderTriggered := func(b *v1alpha1.Build) *v1alpha1.Build {
+					b.Annotations = map[string]string{v1alpha1.BuildReasonAnnotation: "BUILDPACK"}
+					return b
+				}
+
+				recentlyStarted := func() (*v1alpha1.Build, *corev1.Pod) {
+					b := builderTriggered(otherBuild("other-namespace", "started-build", true, time.Now().Add(-time.Hour)))
+					pod, err := podGenerator.Generate(b)
+					require.NoError(t, err)
+					pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-10 * time.Second))
+					return b, pod
+				}
+
+				it("keeps builder triggered builds pending once the rate is reached", func() {
+					limits.BuilderRolloutRate = 1
+					builderTriggered(build)
+					startedBuild, startedPod := recentlyStarted()
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							build,
+							startedBuild,
+							startedPod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: build.ObjectMeta,
+									Spec:       build.Spec,
+									Status:     pendingStatus("BuilderRolloutRate", 1),
+								},
+							},
+						},
+					})
+				})
+
+				it("does not delay builds with other reasons", func() {
+					limits.BuilderRolloutRate = 1
+					build.Annotations = map[string]string{v1alpha1.BuildReasonAnnotation: "COMMIT,BUILDPACK"}
+					startedBuild, startedPod := recentlyStarted()
+
+					buildPod, err := podGenerator.Generate(build)
+					require.NoError(t, err)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							build,
+							startedBuild,
+							startedPod,
+						},
+						WantErr: false,
+						WantCreates: []runtime.Object{
+							buildPod,
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: build.ObjectMeta,
+									Spec:       build.Spec,
+									Status: v1alpha1.BuildStatus{
+										Status: duckv1alpha1.Status{
+											ObservedGeneration: originalGeneration,
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
+											},
+										},
+										PodName: "build-name-build-pod",
+									},
+								},
+							},
+						},
+					})
+				})
+			})
+		})
+
 		when("pod executing", func() {
 			it("updates the status with the status of the pod", func() {
 				pod, err := podGenerator.Generate(build)
@@ -362,6 +757,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									StepsCompleted: []string{
 										"step-1",
 									},
+									StartTime: &metav1.Time{Time: startTime},
 								},
 							},
 						},
@@ -380,13 +776,26 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 					Version: "1.1",
 				}},
 				RunImage: "somerun/123@sha256:12334563ad",
+				Tags: []v1alpha1.PushedTag{
+					{Tag: "someimage/name", Digest: "sha256:1234567"},
+					{Tag: "someimage/name:b1.20191001.120000", Digest: "sha256:1234567"},
+				},
 			}
 			fakeMetadataRetriever.GetBuiltImageReturns(builtImage, nil)
+			fakeImageLabeler.LabelReturns(builtImage, nil)
+
+			sourceLabels := map[string]string{
+				v1alpha1.OCISourceLabel:   "giturl.com/git.git",
+				v1alpha1.OCIRevisionLabel: "gitrev1234",
+			}
 
 			it("sets the build status to Succeeded", func() {
 				pod, err := podGenerator.Generate(build)
 				require.NoError(t, err)
 				pod.Status.Phase = corev1.PodSucceeded
+				startTime := metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+				stepOneFinishTime := metav1.NewTime(startTime.Add(time.Minute))
+				completionTime := metav1.NewTime(startTime.Add(2 * time.Minute))
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
 					{
 						Name: "step-1",
@@ -396,6 +805,8 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 								Reason:      "Terminated",
 								Message:     "Message",
 								ContainerID: "container.ID",
+								StartedAt:   startTime,
+								FinishedAt:  stepOneFinishTime,
 							},
 						},
 					},
@@ -407,6 +818,8 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 								Reason:      "Terminated",
 								Message:     "Message",
 								ContainerID: "container.ID2",
+								StartedAt:   stepOneFinishTime,
+								FinishedAt:  completionTime,
 							},
 						},
 					},
@@ -442,6 +855,8 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									}},
 									LatestImage: identifier,
 									RunImage:    "somerun/123@sha256:12334563ad",
+									PushedTags:  builtImage.Tags,
+									BOM:         builtImage.BOM,
 									StepStates: []corev1.ContainerState{
 										{
 											Terminated: &corev1.ContainerStateTerminated{
@@ -449,6 +864,8 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 												Reason:      "Terminated",
 												Message:     "Message",
 												ContainerID: "container.ID",
+												StartedAt:   startTime,
+												FinishedAt:  stepOneFinishTime,
 											},
 										},
 										{
@@ -457,6 +874,8 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 												Reason:      "Terminated",
 												Message:     "Message",
 												ContainerID: "container.ID2",
+												StartedAt:   stepOneFinishTime,
+												FinishedAt:  completionTime,
 											},
 										},
 									},
@@ -464,6 +883,13 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										"step-1",
 										"step-2",
 									},
+									StartTime:      &startTime,
+									CompletionTime: &completionTime,
+									ImageLabels: map[string]string{
+										v1alpha1.OCISourceLabel:   "giturl.com/git.git",
+										v1alpha1.OCIRevisionLabel: "gitrev1234",
+										v1alpha1.OCICreatedLabel:  "2019-10-01T12:02:00Z",
+									},
 								},
 							},
 						},
@@ -473,57 +899,727 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 				assert.Equal(t, fakeMetadataRetriever.GetBuiltImageCallCount(), 1)
 			})
 
-			it("does not fetch metadata if already retrieved", func() {
+			it("records the cache size reported by the cache size step", func() {
 				pod, err := podGenerator.Generate(build)
 				require.NoError(t, err)
 				pod.Status.Phase = corev1.PodSucceeded
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
 					{
-						Name: "step-1",
-						State: corev1.ContainerState{
-							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    0,
-								Reason:      "Terminated",
-								Message:     "Message",
-								ContainerID: "container.ID",
-							},
-						},
-					},
-					{
-						Name: "step-2",
+						Name: "cache-size",
 						State: corev1.ContainerState{
 							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    0,
-								Reason:      "Terminated",
-								Message:     "Message",
-								ContainerID: "container.ID2",
+								ExitCode: 0,
+								Message:  "512Mi",
 							},
 						},
 					},
 				}
+				cacheSize := resource.MustParse("512Mi")
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						builder,
-						&v1alpha1.Build{
-							ObjectMeta: build.ObjectMeta,
-							Spec:       build.Spec,
-							Status: v1alpha1.BuildStatus{
-								Status: duckv1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: duckv1alpha1.Conditions{
-										{
-											Type:   duckv1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionTrue,
-										},
-									},
-								},
-								BuildMetadata: v1alpha1.BuildpackMetadataList{{
-									ID:      "io.buildpack.previouslyfetched",
-									Version: "1.1",
-								}},
-								PodName:     "build-name-build-pod",
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									LatestImage: identifier,
+									RunImage:    "somerun/123@sha256:12334563ad",
+									PushedTags:  builtImage.Tags,
+									BOM:         builtImage.BOM,
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode: 0,
+												Message:  "512Mi",
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"cache-size",
+									},
+									CacheSize:   &cacheSize,
+									ImageLabels: sourceLabels,
+								},
+							},
+						},
+					},
+				})
+			})
+
+			when("the built image has a bill of materials", func() {
+				bomEntry := func(i int) v1alpha1.BOMEntry {
+					return v1alpha1.BOMEntry{
+						Name:      fmt.Sprintf("some-dependency-%d", i),
+						Version:   "1.0.0",
+						Buildpack: v1alpha1.BOMBuildpack{ID: "io.buildpack.executed", Version: "1.1"},
+						Licenses:  []string{"MIT"},
+					}
+				}
+
+				succeededPod := func() *corev1.Pod {
+					pod, err := podGenerator.Generate(build)
+					require.NoError(t, err)
+					pod.Status.Phase = corev1.PodSucceeded
+					return pod
+				}
+
+				succeededStatus := func(bom []v1alpha1.BOMEntry) v1alpha1.BuildStatus {
+					return v1alpha1.BuildStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions: duckv1alpha1.Conditions{
+								{
+									Type:   duckv1alpha1.ConditionSucceeded,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+						PodName: "build-name-build-pod",
+						BuildMetadata: v1alpha1.BuildpackMetadataList{{
+							ID:      "io.buildpack.executed",
+							Version: "1.1",
+						}},
+						LatestImage:  identifier,
+						RunImage:     "somerun/123@sha256:12334563ad",
+						PushedTags:   builtImage.Tags,
+						BOM:          bom,
+						BOMConfigMap: "build-name-bom",
+						ImageLabels:  sourceLabels,
+					}
+				}
+
+				it("stores the bill of materials in a config map owned by the build", func() {
+					bom := []v1alpha1.BOMEntry{bomEntry(1), bomEntry(2)}
+					imageWithBOM := builtImage
+					imageWithBOM.BOM = bom
+					fakeMetadataRetriever.GetBuiltImageReturns(imageWithBOM, nil)
+
+					configMap, err := build.BOMConfigMap(bom)
+					require.NoError(t, err)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							build,
+							succeededPod(),
+						},
+						WantErr: false,
+						WantCreates: []runtime.Object{
+							configMap,
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: build.ObjectMeta,
+									Spec:       build.Spec,
+									Status:     succeededStatus(bom),
+								},
+							},
+						},
+					})
+				})
+
+				it("lists a bounded part of the bill of materials in the status", func() {
+					bom := make([]v1alpha1.BOMEntry, 0, v1alpha1.MaxStatusBOMEntries+1)
+					for i := 0; i <= v1alpha1.MaxStatusBOMEntries; i++ {
+						bom = append(bom, bomEntry(i))
+					}
+					imageWithBOM := builtImage
+					imageWithBOM.BOM = bom
+					fakeMetadataRetriever.GetBuiltImageReturns(imageWithBOM, nil)
+
+					configMap, err := build.BOMConfigMap(bom)
+					require.NoError(t, err)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							build,
+							succeededPod(),
+						},
+						WantErr: false,
+						WantCreates: []runtime.Object{
+							configMap,
+						},
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: build.ObjectMeta,
+									Spec:       build.Spec,
+									Status:     succeededStatus(bom[:v1alpha1.MaxStatusBOMEntries]),
+								},
+							},
+						},
+					})
+
+					stored, err := v1alpha1.ReadBOMConfigMap(configMap)
+					require.NoError(t, err)
+					assert.Equal(t, bom, stored)
+				})
+			})
+
+			when("labeling the built image", func() {
+				var (
+					labeledBuild *v1alpha1.Build
+					pod          *corev1.Pod
+				)
+
+				it.Before(func() {
+					labeledBuild = build.DeepCopy()
+					labeledBuild.Spec.ImageLabels = map[string]string{"com.example.team": "payments"}
+
+					var err error
+					pod, err = podGenerator.Generate(labeledBuild)
+					require.NoError(t, err)
+					pod.Status.Phase = corev1.PodSucceeded
+				})
+
+				labels := map[string]string{
+					v1alpha1.OCISourceLabel:   "giturl.com/git.git",
+					v1alpha1.OCIRevisionLabel: "gitrev1234",
+					"com.example.team":        "payments",
+				}
+
+				succeededStatus := func(conditions duckv1alpha1.Conditions, latestImage string, tags []v1alpha1.PushedTag, imageLabels map[string]string) v1alpha1.BuildStatus {
+					return v1alpha1.BuildStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions:         conditions,
+						},
+						PodName: "build-name-build-pod",
+						BuildMetadata: v1alpha1.BuildpackMetadataList{{
+							ID:      "io.buildpack.executed",
+							Version: "1.1",
+						}},
+						LatestImage: latestImage,
+						RunImage:    "somerun/123@sha256:12334563ad",
+						PushedTags:  tags,
+						BOM:         builtImage.BOM,
+						ImageLabels: imageLabels,
+					}
+				}
+
+				it("records the labeled image and the applied labels", func() {
+					labeledTags := []v1alpha1.PushedTag{
+						{Tag: "someimage/name", Digest: "sha256:labeled"},
+						{Tag: "someimage/name:b1.20191001.120000", Digest: "sha256:labeled"},
+					}
+					fakeImageLabeler.LabelReturns(cnb.BuiltImage{
+						Identifier: "someimage/name@sha256:labeled",
+						Tags:       labeledTags,
+					}, nil)
+
+					labeledStatus := succeededStatus(duckv1alpha1.Conditions{
+						{
+							Type:   duckv1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+						},
+					}, "someimage/name@sha256:labeled", labeledTags, labels)
+					labeledStatus.ExportedImage = identifier
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							labeledBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: labeledBuild.ObjectMeta,
+									Spec:       labeledBuild.Spec,
+									Status:     labeledStatus,
+								},
+							},
+						},
+					})
+
+					require.Equal(t, 1, fakeImageLabeler.LabelCallCount())
+					labeled, appliedLabels := fakeImageLabeler.LabelArgsForCall(0)
+					assert.Equal(t, buildName, labeled.Name)
+					assert.Equal(t, labels, appliedLabels)
+				})
+
+				it("keeps the build running and retries when the image cannot be labeled", func() {
+					fakeImageLabeler.LabelReturns(cnb.BuiltImage{}, errors.New("unauthorized"))
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							labeledBuild,
+							pod,
+						},
+						WantErr: true,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: labeledBuild.ObjectMeta,
+									Spec:       labeledBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:    duckv1alpha1.ConditionSucceeded,
+											Status:  corev1.ConditionUnknown,
+											Message: "failed to label image: unauthorized",
+										},
+									}, identifier, builtImage.Tags, nil),
+								},
+							},
+						},
+					})
+				})
+
+				it("does not label builds that are already labeled", func() {
+					labeledBuild.Status = succeededStatus(duckv1alpha1.Conditions{
+						{
+							Type:   duckv1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionUnknown,
+						},
+					}, identifier, builtImage.Tags, labels)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							labeledBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: labeledBuild.ObjectMeta,
+									Spec:       labeledBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									}, identifier, builtImage.Tags, labels),
+								},
+							},
+						},
+					})
+
+					assert.Equal(t, 0, fakeImageLabeler.LabelCallCount())
+				})
+			})
+
+			when("signing is configured", func() {
+				var (
+					signedBuild *v1alpha1.Build
+					pod         *corev1.Pod
+				)
+
+				it.Before(func() {
+					signedBuild = build.DeepCopy()
+					signedBuild.Spec.SigningSecret = "signing-key"
+
+					var err error
+					pod, err = podGenerator.Generate(signedBuild)
+					require.NoError(t, err)
+					pod.Status.Phase = corev1.PodSucceeded
+				})
+
+				succeededStatus := func(conditions duckv1alpha1.Conditions, signature *v1alpha1.BuildSignature) v1alpha1.BuildStatus {
+					return v1alpha1.BuildStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions:         conditions,
+						},
+						PodName: "build-name-build-pod",
+						BuildMetadata: v1alpha1.BuildpackMetadataList{{
+							ID:      "io.buildpack.executed",
+							Version: "1.1",
+						}},
+						LatestImage: identifier,
+						RunImage:    "somerun/123@sha256:12334563ad",
+						PushedTags:  builtImage.Tags,
+						BOM:         builtImage.BOM,
+						Signature:   signature,
+						ImageLabels: sourceLabels,
+					}
+				}
+
+				it("records the signature of the built image", func() {
+					signature := v1alpha1.BuildSignature{
+						Signature:   "someimage/name:sha256-1234567.sig",
+						Attestation: "someimage/name:sha256-1234567.att",
+					}
+					fakeImageSigner.SignReturns(signature, nil)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							signedBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: signedBuild.ObjectMeta,
+									Spec:       signedBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+										{
+											Type:   v1alpha1.BuildConditionSigned,
+											Status: corev1.ConditionTrue,
+										},
+									}, &signature),
+								},
+							},
+						},
+					})
+
+					require.Equal(t, 1, fakeImageSigner.SignCallCount())
+					assert.Equal(t, identifier, fakeImageSigner.SignArgsForCall(0).Status.LatestImage)
+				})
+
+				it("reports a failure to sign in the signed condition and retries", func() {
+					fakeImageSigner.SignReturns(v1alpha1.BuildSignature{}, errors.New("no key"))
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							signedBuild,
+							pod,
+						},
+						WantErr: true,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: signedBuild.ObjectMeta,
+									Spec:       signedBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+										{
+											Type:    v1alpha1.BuildConditionSigned,
+											Status:  corev1.ConditionFalse,
+											Message: "failed to sign image: no key",
+										},
+									}, nil),
+								},
+							},
+						},
+					})
+				})
+
+				it("signs succeeded builds that failed to sign before", func() {
+					signature := v1alpha1.BuildSignature{
+						Signature:   "someimage/name:sha256-1234567.sig",
+						Attestation: "someimage/name:sha256-1234567.att",
+					}
+					fakeImageSigner.SignReturns(signature, nil)
+					signedBuild.Status = succeededStatus(duckv1alpha1.Conditions{
+						{
+							Type:   duckv1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+						},
+						{
+							Type:    v1alpha1.BuildConditionSigned,
+							Status:  corev1.ConditionFalse,
+							Message: "failed to sign image: no key",
+						},
+					}, nil)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							signedBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: signedBuild.ObjectMeta,
+									Spec:       signedBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+										{
+											Type:   v1alpha1.BuildConditionSigned,
+											Status: corev1.ConditionTrue,
+										},
+									}, &signature),
+								},
+							},
+						},
+					})
+
+					assert.Equal(t, 0, fakeMetadataRetriever.GetBuiltImageCallCount())
+				})
+
+				it("does not sign builds that are already signed", func() {
+					signedBuild.Status = succeededStatus(duckv1alpha1.Conditions{
+						{
+							Type:   duckv1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionUnknown,
+						},
+					}, &v1alpha1.BuildSignature{
+						Signature:   "someimage/name:sha256-1234567.sig",
+						Attestation: "someimage/name:sha256-1234567.att",
+					})
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							signedBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: signedBuild.ObjectMeta,
+									Spec:       signedBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									}, signedBuild.Status.Signature),
+								},
+							},
+						},
+					})
+
+					assert.Equal(t, 0, fakeImageSigner.SignCallCount())
+				})
+			})
+
+			when("the build has tags in other registries", func() {
+				var (
+					mirroredBuild *v1alpha1.Build
+					pod           *corev1.Pod
+				)
+
+				it.Before(func() {
+					mirroredBuild = build.DeepCopy()
+					mirroredBuild.Spec.Tags = append(mirroredBuild.Spec.Tags, "eu.gcr.io/someimage/name")
+
+					var err error
+					pod, err = podGenerator.Generate(mirroredBuild)
+					require.NoError(t, err)
+					pod.Status.Phase = corev1.PodSucceeded
+				})
+
+				succeededStatus := func(conditions duckv1alpha1.Conditions, pushedTags []v1alpha1.PushedTag) v1alpha1.BuildStatus {
+					return v1alpha1.BuildStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions:         conditions,
+						},
+						PodName: "build-name-build-pod",
+						BuildMetadata: v1alpha1.BuildpackMetadataList{{
+							ID:      "io.buildpack.executed",
+							Version: "1.1",
+						}},
+						LatestImage: identifier,
+						RunImage:    "somerun/123@sha256:12334563ad",
+						PushedTags:  pushedTags,
+						BOM:         builtImage.BOM,
+						ImageLabels: sourceLabels,
+					}
+				}
+
+				it("copies the built image to the tags in other registries", func() {
+					fakeImagePromoter.PromoteReturns("eu.gcr.io/someimage/name@sha256:f1d29b3bc4cfa4d3cc27c87e6ad9ab3d6a4a9bd1a9b43fa1b39c9b3cbc2a3e1f", nil)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							mirroredBuild,
+							pod,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: mirroredBuild.ObjectMeta,
+									Spec:       mirroredBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+										{
+											Type:   v1alpha1.BuildConditionMirrored,
+											Status: corev1.ConditionTrue,
+										},
+									}, append(builtImage.Tags[:len(builtImage.Tags):len(builtImage.Tags)], v1alpha1.PushedTag{
+										Tag:    "eu.gcr.io/someimage/name",
+										Digest: "sha256:f1d29b3bc4cfa4d3cc27c87e6ad9ab3d6a4a9bd1a9b43fa1b39c9b3cbc2a3e1f",
+									})),
+								},
+							},
+						},
+					})
+
+					require.Equal(t, 1, fakeImagePromoter.PromoteCallCount())
+					source, tag, secretRef := fakeImagePromoter.PromoteArgsForCall(0)
+					assert.Equal(t, identifier, source)
+					assert.Equal(t, "eu.gcr.io/someimage/name", tag)
+					assert.Equal(t, registry.SecretRef{
+						ServiceAccount: serviceAccountName,
+						Namespace:      namespace,
+					}, secretRef)
+				})
+
+				it("reports a failure to copy in the mirrored condition and retries", func() {
+					fakeImagePromoter.PromoteReturns("", errors.New("unauthorized"))
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							mirroredBuild,
+							pod,
+						},
+						WantErr: true,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Build{
+									ObjectMeta: mirroredBuild.ObjectMeta,
+									Spec:       mirroredBuild.Spec,
+									Status: succeededStatus(duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+										{
+											Type:    v1alpha1.BuildConditionMirrored,
+											Status:  corev1.ConditionFalse,
+											Message: "failed to copy image to eu.gcr.io/someimage/name: unauthorized",
+										},
+									}, builtImage.Tags),
+								},
+							},
+						},
+					})
+				})
+
+				it("does not copy the image again once the tags are pushed", func() {
+					pushedTags := append(builtImage.Tags[:len(builtImage.Tags):len(builtImage.Tags)], v1alpha1.PushedTag{
+						Tag:    "eu.gcr.io/someimage/name",
+						Digest: "sha256:1234567",
+					})
+					mirroredBuild.Status = succeededStatus(duckv1alpha1.Conditions{
+						{
+							Type:   duckv1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+						},
+						{
+							Type:   v1alpha1.BuildConditionMirrored,
+							Status: corev1.ConditionTrue,
+						},
+					}, pushedTags)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							builder,
+							mirroredBuild,
+							pod,
+						},
+						WantErr: false,
+					})
+
+					assert.Equal(t, 0, fakeImagePromoter.PromoteCallCount())
+				})
+			})
+
+			it("does not fetch metadata if already retrieved", func() {
+				pod, err := podGenerator.Generate(build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "step-1",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    0,
+								Reason:      "Terminated",
+								Message:     "Message",
+								ContainerID: "container.ID",
+							},
+						},
+					},
+					{
+						Name: "step-2",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    0,
+								Reason:      "Terminated",
+								Message:     "Message",
+								ContainerID: "container.ID2",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						&v1alpha1.Build{
+							ObjectMeta: build.ObjectMeta,
+							Spec:       build.Spec,
+							Status: v1alpha1.BuildStatus{
+								Status: duckv1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								BuildMetadata: v1alpha1.BuildpackMetadataList{{
+									ID:      "io.buildpack.previouslyfetched",
+									Version: "1.1",
+								}},
+								PodName:     "build-name-build-pod",
 								LatestImage: "previously/fetched@sha256:abcd",
 								StepStates: []corev1.ContainerState{
 									{
@@ -587,22 +1683,191 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										Terminated: &corev1.ContainerStateTerminated{
 											ExitCode:    0,
 											Reason:      "Terminated",
-											Message:     "Message",
+											Message:     "Message",
+											ContainerID: "container.ID",
+										},
+									},
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID2",
+										},
+									},
+								},
+								StepsCompleted: []string{
+									"step-1",
+									"step-2",
+								},
+							},
+						},
+					},
+					WantErr: false,
+				})
+			})
+
+		})
+
+		when("pod failed", func() {
+			it("sets the build status to Failed", func() {
+				pod, err := podGenerator.Generate(build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodFailed
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "step-1",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    1,
+								Reason:      "Terminated",
+								Message:     "Errors",
+								ContainerID: "container.ID",
+							},
+						},
+					},
+					{
+						Name: "step-2",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason:  "Waiting",
+								Message: "My Turn",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.BuildStepFailed,
+												Message: "step step-1 failed with exit code 1: Errors",
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode:    1,
+												Reason:      "Terminated",
+												Message:     "Errors",
+												ContainerID: "container.ID",
+											},
+										},
+										{
+											Waiting: &corev1.ContainerStateWaiting{
+												Reason:  "Waiting",
+												Message: "My Turn",
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"step-1",
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("uses the pod failure reason when no step failed", func() {
+				pod, err := podGenerator.Generate(build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodFailed
+				pod.Status.Reason = "Evicted"
+				pod.Status.Message = "The node was low on resource: ephemeral-storage."
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.BuildPodFailed,
+												Message: "Evicted The node was low on resource: ephemeral-storage.",
+											},
+										},
+									},
+									PodName:        "build-name-build-pod",
+									StepStates:     []corev1.ContainerState{},
+									StepsCompleted: []string{},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("does not recreate pods if build has finished", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						&v1alpha1.Build{
+							ObjectMeta: build.ObjectMeta,
+							Spec:       build.Spec,
+							Status: v1alpha1.BuildStatus{
+								Status: duckv1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionFalse,
+										},
+									},
+								},
+								PodName: "build-name-build-pod",
+								StepStates: []corev1.ContainerState{
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    1,
+											Reason:      "Terminated",
+											Message:     "Errors",
 											ContainerID: "container.ID",
 										},
 									},
 									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID2",
+										Waiting: &corev1.ContainerStateWaiting{
+											Reason:  "Waiting",
+											Message: "My Turn",
 										},
 									},
 								},
 								StepsCompleted: []string{
 									"step-1",
-									"step-2",
 								},
 							},
 						},
@@ -610,129 +1875,497 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 					WantErr: false,
 				})
 			})
-
 		})
 
-		when("pod failed", func() {
-			it("sets the build status to Failed", func() {
-				pod, err := podGenerator.Generate(build)
+		when("the build has multiple platforms", func() {
+			const indexDigest = "sha256:a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2"
+
+			platformBuild := build.DeepCopy()
+			platformBuild.Spec.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+			startTime := metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+			completionTime := metav1.NewTime(startTime.Add(5 * time.Minute))
+
+			platformPod := func(platform string, phase corev1.PodPhase, exitCode int32, finishedAt metav1.Time) *corev1.Pod {
+				pod, err := podGenerator.GenerateForPlatform(platformBuild, platform)
 				require.NoError(t, err)
-				pod.Status.Phase = corev1.PodFailed
+				pod.Status.Phase = phase
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
 					{
 						Name: "step-1",
 						State: corev1.ContainerState{
 							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    1,
-								Reason:      "Terminated",
-								Message:     "Errors",
-								ContainerID: "container.ID",
+								ExitCode:   exitCode,
+								StartedAt:  startTime,
+								FinishedAt: finishedAt,
 							},
 						},
 					},
-					{
-						Name: "step-2",
-						State: corev1.ContainerState{
-							Waiting: &corev1.ContainerStateWaiting{
-								Reason:  "Waiting",
-								Message: "My Turn",
+				}
+				return pod
+			}
+
+			amd64Image := cnb.BuiltImage{
+				Identifier: "someimage/name@sha256:amd64",
+				BuildpackMetadata: []lcyclemd.BuildpackMetadata{{
+					ID:      "io.buildpack.executed",
+					Version: "1.1",
+				}},
+				RunImage: "somerun/123@sha256:amd64",
+			}
+			arm64Image := cnb.BuiltImage{
+				Identifier: "someimage/name@sha256:arm64",
+				RunImage:   "somerun/123@sha256:arm64",
+			}
+
+			it("schedules a pod per platform", func() {
+				amd64Pod, err := podGenerator.GenerateForPlatform(platformBuild, "linux/amd64")
+				require.NoError(t, err)
+				arm64Pod, err := podGenerator.GenerateForPlatform(platformBuild, "linux/arm64")
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						platformBuild,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						amd64Pod,
+						arm64Pod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									Platforms: []v1alpha1.BuildPlatformStatus{
+										{
+											Platform: "linux/amd64",
+											PodName:  "build-name-build-pod-linux-amd64",
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
+											},
+										},
+										{
+											Platform: "linux/arm64",
+											PodName:  "build-name-build-pod-linux-arm64",
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
+											},
+										},
+									},
+								},
 							},
 						},
 					},
-				}
+				})
+			})
+
+			it("waits for every platform before pushing the image index", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(amd64Image, nil)
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						builder,
-						build,
-						pod,
+						platformBuild,
+						platformPod("linux/amd64", corev1.PodSucceeded, 0, completionTime),
+						platformPod("linux/arm64", corev1.PodRunning, 0, completionTime),
 					},
 					WantErr: false,
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
 							Object: &v1alpha1.Build{
-								ObjectMeta: build.ObjectMeta,
-								Spec:       build.Spec,
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
 								Status: v1alpha1.BuildStatus{
 									Status: duckv1alpha1.Status{
 										ObservedGeneration: originalGeneration,
 										Conditions: duckv1alpha1.Conditions{
 											{
 												Type:   duckv1alpha1.ConditionSucceeded,
-												Status: corev1.ConditionFalse,
+												Status: corev1.ConditionUnknown,
 											},
 										},
 									},
-									PodName: "build-name-build-pod",
-									StepStates: []corev1.ContainerState{
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									RunImage:  "somerun/123@sha256:amd64",
+									StartTime: &startTime,
+									Platforms: []v1alpha1.BuildPlatformStatus{
 										{
-											Terminated: &corev1.ContainerStateTerminated{
-												ExitCode:    1,
-												Reason:      "Terminated",
-												Message:     "Errors",
-												ContainerID: "container.ID",
+											Platform:       "linux/amd64",
+											PodName:        "build-name-build-pod-linux-amd64",
+											LatestImage:    "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
 											},
 										},
 										{
-											Waiting: &corev1.ContainerStateWaiting{
-												Reason:  "Waiting",
-												Message: "My Turn",
+											Platform:       "linux/arm64",
+											PodName:        "build-name-build-pod-linux-arm64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionUnknown,
+												},
 											},
 										},
 									},
-									StepsCompleted: []string{
-										"step-1",
+								},
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 1, fakeMetadataRetriever.GetBuiltImageCallCount())
+				assert.Equal(t, "someimage/name:latest-linux-amd64", fakeMetadataRetriever.GetBuiltImageArgsForCall(0).Tag())
+				assert.Equal(t, 0, fakeIndexWriter.WriteCallCount())
+			})
+
+			it("pushes an image index to the tags once every platform succeeded", func() {
+				fakeMetadataRetriever.GetBuiltImageReturnsOnCall(0, amd64Image, nil)
+				fakeMetadataRetriever.GetBuiltImageReturnsOnCall(1, arm64Image, nil)
+				fakeImageLabeler.LabelReturnsOnCall(0, cnb.BuiltImage{Identifier: "someimage/name@sha256:labeled-amd64"}, nil)
+				fakeImageLabeler.LabelReturnsOnCall(1, cnb.BuiltImage{Identifier: "someimage/name@sha256:labeled-arm64"}, nil)
+				fakeIndexWriter.WriteReturns("someimage/name@"+indexDigest, nil)
+				laterCompletionTime := metav1.NewTime(completionTime.Add(time.Minute))
+
+				completedBuild := platformBuild.DeepCopy()
+				completedBuild.Status.CompletionTime = &laterCompletionTime
+				labels := completedBuild.ImageLabels()
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						platformBuild,
+						platformPod("linux/amd64", corev1.PodSucceeded, 0, completionTime),
+						platformPod("linux/arm64", corev1.PodSucceeded, 0, laterCompletionTime),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									RunImage:    "somerun/123@sha256:amd64",
+									LatestImage: "someimage/name@" + indexDigest,
+									PushedTags: []v1alpha1.PushedTag{
+										{Tag: "someimage/name", Digest: indexDigest},
+										{Tag: "someimage/name:tag2", Digest: indexDigest},
+										{Tag: "someimage/name:tag3", Digest: indexDigest},
+									},
+									StartTime:      &startTime,
+									CompletionTime: &laterCompletionTime,
+									ImageLabels:    labels,
+									Platforms: []v1alpha1.BuildPlatformStatus{
+										{
+											Platform:       "linux/amd64",
+											PodName:        "build-name-build-pod-linux-amd64",
+											LatestImage:    "someimage/name@sha256:labeled-amd64",
+											ExportedImage:  "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										{
+											Platform:       "linux/arm64",
+											PodName:        "build-name-build-pod-linux-arm64",
+											LatestImage:    "someimage/name@sha256:labeled-arm64",
+											ExportedImage:  "someimage/name@sha256:arm64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
 									},
 								},
 							},
 						},
 					},
 				})
+
+				require.Equal(t, 2, fakeImageLabeler.LabelCallCount())
+				for i, platform := range []string{"linux/amd64", "linux/arm64"} {
+					labeled, appliedLabels := fakeImageLabeler.LabelArgsForCall(i)
+					assert.Equal(t, []string{platformBuild.PlatformTag(platform)}, labeled.Spec.Tags)
+					assert.Equal(t, labels, appliedLabels)
+				}
+
+				require.Equal(t, 1, fakeIndexWriter.WriteCallCount())
+				tags, images, secretRef := fakeIndexWriter.WriteArgsForCall(0)
+				assert.Equal(t, platformBuild.Spec.Tags, tags)
+				assert.Equal(t, []registry.PlatformImage{
+					{Platform: "linux/amd64", Image: "someimage/name@sha256:labeled-amd64"},
+					{Platform: "linux/arm64", Image: "someimage/name@sha256:labeled-arm64"},
+				}, images)
+				assert.Equal(t, registry.SecretRef{ServiceAccount: serviceAccountName, Namespace: namespace}, secretRef)
 			})
 
-			it("does not recreate pods if build has finished", func() {
+			it("retries labeling the platform images when it fails", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(amd64Image, nil)
+				fakeImageLabeler.LabelReturns(cnb.BuiltImage{}, errors.New("unauthorized"))
+
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
 						builder,
-						&v1alpha1.Build{
-							ObjectMeta: build.ObjectMeta,
-							Spec:       build.Spec,
-							Status: v1alpha1.BuildStatus{
-								Status: duckv1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: duckv1alpha1.Conditions{
+						platformBuild,
+						platformPod("linux/amd64", corev1.PodSucceeded, 0, completionTime),
+						platformPod("linux/arm64", corev1.PodSucceeded, 0, completionTime),
+					},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionUnknown,
+												Message: "failed to label image: unauthorized",
+											},
+										},
+									},
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									RunImage:       "somerun/123@sha256:amd64",
+									StartTime:      &startTime,
+									CompletionTime: &completionTime,
+									Platforms: []v1alpha1.BuildPlatformStatus{
 										{
-											Type:   duckv1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionFalse,
+											Platform:       "linux/amd64",
+											PodName:        "build-name-build-pod-linux-amd64",
+											LatestImage:    "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										{
+											Platform:       "linux/arm64",
+											PodName:        "build-name-build-pod-linux-arm64",
+											LatestImage:    "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
 										},
 									},
 								},
-								PodName: "build-name-build-pod",
-								StepStates: []corev1.ContainerState{
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    1,
-											Reason:      "Terminated",
-											Message:     "Errors",
-											ContainerID: "container.ID",
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeIndexWriter.WriteCallCount())
+			})
+
+			it("fails the build when the pod of a platform fails", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(amd64Image, nil)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						platformBuild,
+						platformPod("linux/amd64", corev1.PodSucceeded, 0, completionTime),
+						platformPod("linux/arm64", corev1.PodFailed, 1, completionTime),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.BuildStepFailed,
+												Message: "linux/arm64: step step-1 failed with exit code 1",
+											},
 										},
 									},
-									{
-										Waiting: &corev1.ContainerStateWaiting{
-											Reason:  "Waiting",
-											Message: "My Turn",
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									RunImage:       "somerun/123@sha256:amd64",
+									StartTime:      &startTime,
+									CompletionTime: &completionTime,
+									Platforms: []v1alpha1.BuildPlatformStatus{
+										{
+											Platform:       "linux/amd64",
+											PodName:        "build-name-build-pod-linux-amd64",
+											LatestImage:    "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										{
+											Platform:       "linux/arm64",
+											PodName:        "build-name-build-pod-linux-arm64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:    duckv1alpha1.ConditionSucceeded,
+													Status:  corev1.ConditionFalse,
+													Reason:  v1alpha1.BuildStepFailed,
+													Message: "step step-1 failed with exit code 1",
+												},
+											},
 										},
 									},
 								},
-								StepsCompleted: []string{
-									"step-1",
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeIndexWriter.WriteCallCount())
+			})
+
+			it("fails the build when the image index cannot be pushed", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(amd64Image, nil)
+				fakeImageLabeler.LabelReturns(cnb.BuiltImage{Identifier: "someimage/name@sha256:labeled"}, nil)
+				fakeIndexWriter.WriteReturns("", errors.New("some error"))
+
+				completedBuild := platformBuild.DeepCopy()
+				completedBuild.Status.CompletionTime = &completionTime
+				labels := completedBuild.ImageLabels()
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						builder,
+						platformBuild,
+						platformPod("linux/amd64", corev1.PodSucceeded, 0, completionTime),
+						platformPod("linux/arm64", corev1.PodSucceeded, 0, completionTime),
+					},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: platformBuild.ObjectMeta,
+								Spec:       platformBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Message: "failed to push image index: some error",
+											},
+										},
+									},
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										ID:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									RunImage:       "somerun/123@sha256:amd64",
+									StartTime:      &startTime,
+									CompletionTime: &completionTime,
+									ImageLabels:    labels,
+									Platforms: []v1alpha1.BuildPlatformStatus{
+										{
+											Platform:       "linux/amd64",
+											PodName:        "build-name-build-pod-linux-amd64",
+											LatestImage:    "someimage/name@sha256:labeled",
+											ExportedImage:  "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										{
+											Platform:       "linux/arm64",
+											PodName:        "build-name-build-pod-linux-arm64",
+											LatestImage:    "someimage/name@sha256:labeled",
+											ExportedImage:  "someimage/name@sha256:amd64",
+											StepsCompleted: []string{"step-1"},
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionSucceeded,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
 					},
-					WantErr: false,
 				})
 			})
 		})
@@ -770,3 +2403,12 @@ func (testPodGenerator) Generate(build *v1alpha1.Build) (*corev1.Pod, error) {
 		},
 	}, nil
 }
+
+func (g testPodGenerator) GenerateForPlatform(build *v1alpha1.Build, platform string) (*corev1.Pod, error) {
+	pod, err := g.Generate(build)
+	if err != nil {
+		return nil, err
+	}
+	pod.Name = build.PlatformPodName(platform)
+	return pod, nil
+}
@@ -0,0 +1,17 @@
+package build
+
+import (
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type workQueueEnqueuer struct {
+	enqueueAfter func(obj interface{}, after time.Duration)
+	delay        time.Duration
+}
+
+func (e *workQueueEnqueuer) Enqueue(build *v1alpha1.Build) error {
+	e.enqueueAfter(build, e.delay)
+	return nil
+}
@@ -0,0 +1,179 @@
+package build
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const (
+	MaxRunningBuildsReason             = "MaxRunningBuilds"
+	MaxRunningBuildsPerNamespaceReason = "MaxRunningBuildsPerNamespace"
+	BuilderRolloutRateReason           = "BuilderRolloutRate"
+
+	rolloutWindow = time.Minute
+)
+
+// Limits bounds how many build pods are started. A zero value disables the
+// corresponding limit.
+type Limits struct {
+	// MaxRunning is the number of builds that may run at once across all namespaces.
+	MaxRunning int
+	// MaxRunningPerNamespace is the number of builds that may run at once in a namespace.
+	MaxRunningPerNamespace int
+	// BuilderRolloutRate is the number of builds caused only by builder updates
+	// that may start per minute.
+	BuilderRolloutRate int
+}
+
+func (l Limits) enabled() bool {
+	return l.MaxRunning > 0 || l.MaxRunningPerNamespace > 0 || l.BuilderRolloutRate > 0
+}
+
+type pendingBuild struct {
+	reason   string
+	position int
+}
+
+func (p *pendingBuild) conditions() duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionUnknown,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+		{
+			Type:               v1alpha1.BuildConditionPending,
+			Status:             corev1.ConditionTrue,
+			Reason:             p.reason,
+			Message:            fmt.Sprintf("waiting to start at queue position %d", p.position),
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
+
+// pendingBuild returns the limit that keeps build from starting, or nil when
+// its pod may be created. Builds that have not started are admitted in
+// creation order so that a build keeps its place in the queue. Builds are
+// considered started once their pod exists, as the pod name is recorded in the
+// build status only after the pod is created.
+func (c *Reconciler) pendingBuild(build *v1alpha1.Build) (*pendingBuild, error) {
+	if !c.Limits.enabled() {
+		return nil, nil
+	}
+
+	pod, err := c.startedPod(build)
+	if err != nil {
+		return nil, err
+	} else if pod != nil {
+		return nil, nil
+	}
+
+	builds, err := c.Lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		running, runningInNamespace, recentBuilderStarts int
+		queued                                           []*v1alpha1.Build
+	)
+	for _, b := range builds {
+		pod, err := c.startedPod(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if pod != nil {
+			if !b.Finished() {
+				running++
+				if b.Namespace == build.Namespace {
+					runningInNamespace++
+				}
+			}
+
+			if b.BuilderTriggered() && time.Since(pod.CreationTimestamp.Time) < rolloutWindow {
+				recentBuilderStarts++
+			}
+			continue
+		}
+
+		if b.Finished() || b.Rebasable() {
+			continue
+		}
+		queued = append(queued, b)
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return queuedBefore(queued[i], queued[j])
+	})
+
+	ahead, aheadInNamespace, builderTriggeredAhead := 0, 0, 0
+	for _, b := range queued {
+		if b.Namespace == build.Namespace && b.Name == build.Name {
+			break
+		}
+
+		ahead++
+		if b.Namespace == build.Namespace {
+			aheadInNamespace++
+		}
+		if b.BuilderTriggered() {
+			builderTriggeredAhead++
+		}
+	}
+
+	if c.Limits.MaxRunning > 0 && running+ahead >= c.Limits.MaxRunning {
+		return &pendingBuild{reason: MaxRunningBuildsReason, position: ahead + 1}, nil
+	}
+
+	if c.Limits.MaxRunningPerNamespace > 0 && runningInNamespace+aheadInNamespace >= c.Limits.MaxRunningPerNamespace {
+		return &pendingBuild{reason: MaxRunningBuildsPerNamespaceReason, position: aheadInNamespace + 1}, nil
+	}
+
+	if c.Limits.BuilderRolloutRate > 0 && build.BuilderTriggered() && recentBuilderStarts+builderTriggeredAhead >= c.Limits.BuilderRolloutRate {
+		return &pendingBuild{reason: BuilderRolloutRateReason, position: builderTriggeredAhead + 1}, nil
+	}
+
+	return nil, nil
+}
+
+// startedPod returns the pod of the build, or the first existing pod of its
+// platforms, or nil when no pod has been created.
+func (c *Reconciler) startedPod(build *v1alpha1.Build) (*corev1.Pod, error) {
+	var podNames []string
+	for _, platform := range build.Spec.Platforms {
+		podNames = append(podNames, build.PlatformPodName(platform))
+	}
+	if !build.MultiPlatform() {
+		podNames = []string{build.PodName()}
+	}
+
+	for _, podName := range podNames {
+		pod, err := c.PodLister.Pods(build.Namespace).Get(podName)
+		if err == nil {
+			return pod, nil
+		} else if !k8s_errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func queuedBefore(a, b *v1alpha1.Build) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -127,6 +127,219 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				require.Equal(t, fakeMetadataRetriever.GetBuilderImageCallCount(), 1)
 			})
 
+			it("records the order, stack, lifecycle and previous builder image when the builder changes", func() {
+				fakeMetadataRetriever.GetBuilderImageReturns(cnb.BuilderImage{
+					BuilderBuildpackMetadata: cnb.BuilderMetadata{
+						{
+							ID:       "buildpack.version",
+							Version:  "version",
+							Homepage: "https://buildpack.example.com",
+						},
+					},
+					Order: []v1alpha1.OrderEntry{
+						{Group: []v1alpha1.BuildpackRef{{ID: "buildpack.version", Version: "version"}}},
+					},
+					StackID:          "io.buildpacks.stacks.bionic",
+					LifecycleVersion: "0.4.0",
+					Identifier:       builderIdentifier,
+					RunImage:         runImgIdentifier,
+				}, nil)
+
+				previousBuilder := builder.DeepCopy()
+				previousBuilder.Status = v1alpha1.BuilderStatus{
+					Status: duckv1alpha1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					LatestImage:   "some/builder@sha256:previous-builder-digest",
+					RunImage:      runImgIdentifier,
+					PreviousImage: "some/builder@sha256:older-builder-digest",
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{previousBuilder},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Builder{
+								ObjectMeta: builder.ObjectMeta,
+								Spec:       builder.Spec,
+								Status: v1alpha1.BuilderStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: 1,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:   duckv1alpha1.ConditionReady,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									BuilderMetadata: []v1alpha1.BuildpackMetadata{
+										{
+											ID:       "buildpack.version",
+											Version:  "version",
+											Homepage: "https://buildpack.example.com",
+										},
+									},
+									Order: []v1alpha1.OrderEntry{
+										{Group: []v1alpha1.BuildpackRef{{ID: "buildpack.version", Version: "version"}}},
+									},
+									StackID:          "io.buildpacks.stacks.bionic",
+									LifecycleVersion: "0.4.0",
+									LatestImage:      builderIdentifier,
+									RunImage:         runImgIdentifier,
+									PreviousImage:    "some/builder@sha256:previous-builder-digest",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			when("update policy is set to approval", func() {
+				const approvedIdentifier = "some/builder@sha256:approved-builder-digest"
+
+				approvalBuilder := builder.DeepCopy()
+				approvalBuilder.Spec.UpdatePolicy = v1alpha1.Approval
+				approvalBuilder.Status = v1alpha1.BuilderStatus{
+					Status: duckv1alpha1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					BuilderMetadata: []v1alpha1.BuildpackMetadata{
+						{
+							ID:      "buildpack.version",
+							Version: "approved-version",
+						},
+					},
+					LatestImage: approvedIdentifier,
+					RunImage:    runImgIdentifier,
+				}
+
+				it("records a new builder digest as a pending update", func() {
+					expectedBuilder := approvalBuilder.DeepCopy()
+					expectedBuilder.Status.PendingUpdate = builderIdentifier
+
+					rt.Test(rtesting.TableRow{
+						Key:     key,
+						Objects: []runtime.Object{approvalBuilder},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: expectedBuilder,
+							},
+						},
+					})
+					assert.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+				})
+
+				it("promotes the pending update when it is approved", func() {
+					approvedBuilder := approvalBuilder.DeepCopy()
+					approvedBuilder.Status.PendingUpdate = builderIdentifier
+					approvedBuilder.Annotations = map[string]string{
+						v1alpha1.ApprovedBuilderImageAnnotation: builderIdentifier,
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key:     key,
+						Objects: []runtime.Object{approvedBuilder},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Builder{
+									ObjectMeta: approvedBuilder.ObjectMeta,
+									Spec:       approvedBuilder.Spec,
+									Status: v1alpha1.BuilderStatus{
+										Status: duckv1alpha1.Status{
+											ObservedGeneration: 1,
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionReady,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										BuilderMetadata: []v1alpha1.BuildpackMetadata{
+											{
+												ID:      "buildpack.version",
+												Version: "version",
+											},
+										},
+										LatestImage:   builderIdentifier,
+										RunImage:      runImgIdentifier,
+										PreviousImage: approvedIdentifier,
+									},
+								},
+							},
+						},
+					})
+				})
+
+				it("does not promote a pending update approved for a different digest", func() {
+					staleApproval := approvalBuilder.DeepCopy()
+					staleApproval.Status.PendingUpdate = builderIdentifier
+					staleApproval.Annotations = map[string]string{
+						v1alpha1.ApprovedBuilderImageAnnotation: "some/builder@sha256:other-digest",
+					}
+
+					rt.Test(rtesting.TableRow{
+						Key:     key,
+						Objects: []runtime.Object{staleApproval},
+						WantErr: false,
+					})
+				})
+
+				it("uses the builder digest resolved from a changed spec", func() {
+					updatedBuilder := approvalBuilder.DeepCopy()
+					updatedBuilder.Generation = 2
+
+					rt.Test(rtesting.TableRow{
+						Key:     key,
+						Objects: []runtime.Object{updatedBuilder},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.Builder{
+									ObjectMeta: updatedBuilder.ObjectMeta,
+									Spec:       updatedBuilder.Spec,
+									Status: v1alpha1.BuilderStatus{
+										Status: duckv1alpha1.Status{
+											ObservedGeneration: 2,
+											Conditions: duckv1alpha1.Conditions{
+												{
+													Type:   duckv1alpha1.ConditionReady,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										BuilderMetadata: []v1alpha1.BuildpackMetadata{
+											{
+												ID:      "buildpack.version",
+												Version: "version",
+											},
+										},
+										LatestImage:   builderIdentifier,
+										RunImage:      runImgIdentifier,
+										PreviousImage: approvedIdentifier,
+									},
+								},
+							},
+						},
+					})
+				})
+			})
+
 			it("schedule next polling when update policy is not set", func() {
 				rt.Test(rtesting.TableRow{
 					Key:     key,
@@ -299,6 +512,44 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 
 				assert.Equal(t, fakeEnqueuer.EnqueueCallCount(), 1)
 			})
+
+			it("keeps the approved builder when update policy is set to approval", func() {
+				approvalBuilder := builder.DeepCopy()
+				approvalBuilder.Spec.UpdatePolicy = v1alpha1.Approval
+				approvalBuilder.Status = v1alpha1.BuilderStatus{
+					Status: duckv1alpha1.Status{
+						ObservedGeneration: 1,
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					LatestImage: builderIdentifier,
+					RunImage:    runImgIdentifier,
+				}
+
+				expectedBuilder := approvalBuilder.DeepCopy()
+				expectedBuilder.Status.Conditions = duckv1alpha1.Conditions{
+					{
+						Type:    duckv1alpha1.ConditionReady,
+						Status:  corev1.ConditionFalse,
+						Message: "unavailable metadata",
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{approvalBuilder},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: expectedBuilder,
+						},
+					},
+				})
+			})
 		})
 
 		it("does not return error on nonexistent builder", func() {
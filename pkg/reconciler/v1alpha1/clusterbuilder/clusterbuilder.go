@@ -112,36 +112,52 @@ func (c *Reconciler) updateClusterBuilderStatus(desired *v1alpha1.ClusterBuilder
 func (c *Reconciler) reconcileClusterBuilderStatus(builder *v1alpha1.ClusterBuilder) (*v1alpha1.ClusterBuilder, error) {
 	builderImage, err := c.MetadataRetriever.GetBuilderImage(builder)
 	if err != nil {
-		builder.Status = v1alpha1.BuilderStatus{
-			Status: duckv1alpha1.Status{
-				ObservedGeneration: builder.Generation,
-				Conditions: duckv1alpha1.Conditions{
-					{
-						Type:               duckv1alpha1.ConditionReady,
-						Status:             corev1.ConditionFalse,
-						LastTransitionTime: apis.VolatileTime{Inner: v1.Now()},
-						Message:            err.Error(),
-					},
+		failedStatus := duckv1alpha1.Status{
+			ObservedGeneration: builder.Generation,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionReady,
+					Status:             corev1.ConditionFalse,
+					LastTransitionTime: apis.VolatileTime{Inner: v1.Now()},
+					Message:            err.Error(),
 				},
 			},
 		}
+		if builder.Spec.UpdatePolicy == v1alpha1.Approval {
+			// keep the approved builder so that a registry failure does not approve the next digest
+			builder.Status.Status = failedStatus
+			return builder, err
+		}
+
+		builder.Status = v1alpha1.BuilderStatus{Status: failedStatus}
 		return builder, err
 	}
 
-	builder.Status = v1alpha1.BuilderStatus{
-		Status: duckv1alpha1.Status{
-			ObservedGeneration: builder.Generation,
-			Conditions: duckv1alpha1.Conditions{
-				{
-					LastTransitionTime: apis.VolatileTime{Inner: v1.Now()},
-					Type:               duckv1alpha1.ConditionReady,
-					Status:             corev1.ConditionTrue,
-				},
+	readyStatus := duckv1alpha1.Status{
+		ObservedGeneration: builder.Generation,
+		Conditions: duckv1alpha1.Conditions{
+			{
+				LastTransitionTime: apis.VolatileTime{Inner: v1.Now()},
+				Type:               duckv1alpha1.ConditionReady,
+				Status:             corev1.ConditionTrue,
 			},
 		},
-		BuilderMetadata: transform(builderImage.BuilderBuildpackMetadata),
-		LatestImage:     builderImage.Identifier,
-		RunImage:        builderImage.RunImage,
+	}
+	if !builder.UpdateApproved(builderImage.Identifier) {
+		builder.Status.Status = readyStatus
+		builder.Status.PendingUpdate = builderImage.Identifier
+		return builder, nil
+	}
+
+	builder.Status = v1alpha1.BuilderStatus{
+		Status:           readyStatus,
+		BuilderMetadata:  transform(builderImage.BuilderBuildpackMetadata),
+		Order:            builderImage.Order,
+		StackID:          builderImage.StackID,
+		LifecycleVersion: builderImage.LifecycleVersion,
+		LatestImage:      builderImage.Identifier,
+		RunImage:         builderImage.RunImage,
+		PreviousImage:    builder.Status.PreviousImageFor(builderImage.Identifier),
 	}
 	return builder, nil
 }
@@ -151,8 +167,9 @@ func transform(in cnb.BuilderMetadata) v1alpha1.BuildpackMetadataList {
 
 	for _, m := range in {
 		out = append(out, v1alpha1.BuildpackMetadata{
-			ID:      m.ID,
-			Version: m.Version,
+			ID:       m.ID,
+			Version:  m.Version,
+			Homepage: m.Homepage,
 		})
 	}
 
@@ -121,6 +121,74 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 					require.Equal(t, fakeMetadataRetriever.GetBuilderImageCallCount(), 1)
 				})
 
+				it("records the previous builder image when the builder digest changes", func() {
+					previousBuilder := clusterBuilder.DeepCopy()
+					previousBuilder.Status = v1alpha1.BuilderStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: 1,
+							Conditions: duckv1alpha1.Conditions{
+								{
+									Type:   duckv1alpha1.ConditionReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+						BuilderMetadata: []v1alpha1.BuildpackMetadata{
+							{
+								ID:      "buildpack.version",
+								Version: "version",
+							},
+						},
+						LatestImage: "some/cluster-builder@sha256:previous-builder-digest",
+					}
+
+					expectedBuilder := previousBuilder.DeepCopy()
+					expectedBuilder.Status.LatestImage = clusterBuilderIdentifier
+					expectedBuilder.Status.PreviousImage = "some/cluster-builder@sha256:previous-builder-digest"
+
+					rt.Test(rtesting.TableRow{
+						Key:     clusterBuilderKey,
+						Objects: []runtime.Object{previousBuilder},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: expectedBuilder,
+							},
+						},
+					})
+				})
+
+				it("records a new builder digest as a pending update when update policy is set to approval", func() {
+					approvalBuilder := clusterBuilder.DeepCopy()
+					approvalBuilder.Spec.UpdatePolicy = v1alpha1.Approval
+					approvalBuilder.Status = v1alpha1.BuilderStatus{
+						Status: duckv1alpha1.Status{
+							ObservedGeneration: 1,
+							Conditions: duckv1alpha1.Conditions{
+								{
+									Type:   duckv1alpha1.ConditionReady,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+						LatestImage: "some/cluster-builder@sha256:approved-builder-digest",
+					}
+
+					expectedBuilder := approvalBuilder.DeepCopy()
+					expectedBuilder.Status.PendingUpdate = clusterBuilderIdentifier
+
+					rt.Test(rtesting.TableRow{
+						Key:     clusterBuilderKey,
+						Objects: []runtime.Object{approvalBuilder},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: expectedBuilder,
+							},
+						},
+					})
+				})
+
 				it("schedule next polling when update policy is not set", func() {
 					rt.Test(rtesting.TableRow{
 						Key:     clusterBuilderKey,
@@ -0,0 +1,204 @@
+package custombuilder
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/reconciler"
+)
+
+const (
+	ReconcilerName = "CustomBuilders"
+	Kind           = "CustomBuilder"
+)
+
+//go:generate counterfeiter . BuilderCreator
+type BuilderCreator interface {
+	Revisions(customBuilder *v1alpha1.CustomBuilder) ([]string, error)
+	CreateBuilder(customBuilder *v1alpha1.CustomBuilder) (string, error)
+}
+
+//go:generate counterfeiter . MetadataRetriever
+type MetadataRetriever interface {
+	GetBuilderImage(builder v1alpha1.BuilderResource) (cnb.BuilderImage, error)
+}
+
+func NewController(opt reconciler.Options, customBuilderInformer v1alpha1informers.CustomBuilderInformer, builderCreator BuilderCreator, metadataRetriever MetadataRetriever) *controller.Impl {
+	c := &Reconciler{
+		Client:              opt.Client,
+		BuilderCreator:      builderCreator,
+		MetadataRetriever:   metadataRetriever,
+		CustomBuilderLister: customBuilderInformer.Lister(),
+	}
+
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+
+	c.Enqueuer = &workQueueEnqueuer{
+		enqueueAfter: impl.EnqueueAfter,
+		delay:        opt.BuilderPollingFrequency,
+	}
+
+	customBuilderInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	return impl
+}
+
+//go:generate counterfeiter . Enqueuer
+type Enqueuer interface {
+	Enqueue(*v1alpha1.CustomBuilder) error
+}
+
+type Reconciler struct {
+	Client              versioned.Interface
+	BuilderCreator      BuilderCreator
+	MetadataRetriever   MetadataRetriever
+	CustomBuilderLister v1alpha1Listers.CustomBuilderLister
+	Enqueuer            Enqueuer
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, builderName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	customBuilder, err := c.CustomBuilderLister.CustomBuilders(namespace).Get(builderName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	customBuilder = customBuilder.DeepCopy()
+
+	// the stack and store images are polled and the builder is assembled
+	// again once one of them moves to a new digest
+	revisions, err := c.BuilderCreator.Revisions(customBuilder)
+	if err != nil && customBuilder.Ready() {
+		return err
+	}
+
+	if err == nil && customBuilder.Ready() && equality.Semantic.DeepEqual(revisions, customBuilder.Status.ObservedRevisions) {
+		return c.Enqueuer.Enqueue(customBuilder)
+	}
+
+	if err != nil {
+		customBuilder.Status = failedStatus(customBuilder, err)
+	} else {
+		customBuilder, err = c.reconcileCustomBuilderStatus(customBuilder, revisions)
+	}
+
+	updateErr := c.updateStatus(customBuilder)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	if enqueueErr := c.Enqueuer.Enqueue(customBuilder); enqueueErr != nil {
+		return enqueueErr
+	}
+
+	return err
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.CustomBuilder) error {
+	original, err := c.CustomBuilderLister.CustomBuilders(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.BuildV1alpha1().CustomBuilders(desired.Namespace).UpdateStatus(desired)
+	return err
+}
+
+func (c *Reconciler) reconcileCustomBuilderStatus(customBuilder *v1alpha1.CustomBuilder, revisions []string) (*v1alpha1.CustomBuilder, error) {
+	identifier, err := c.BuilderCreator.CreateBuilder(customBuilder)
+	if err != nil {
+		customBuilder.Status = failedStatus(customBuilder, err)
+		return customBuilder, err
+	}
+
+	previousImage := customBuilder.Status.PreviousImageFor(identifier)
+	customBuilder.Status.LatestImage = identifier
+	builderImage, err := c.MetadataRetriever.GetBuilderImage(customBuilder)
+	if err != nil {
+		customBuilder.Status = failedStatus(customBuilder, err)
+		return customBuilder, err
+	}
+
+	customBuilder.Status = v1alpha1.BuilderStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: customBuilder.Generation,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+				},
+			},
+		},
+		BuilderMetadata:   transform(builderImage.BuilderBuildpackMetadata),
+		Order:             builderImage.Order,
+		StackID:           builderImage.StackID,
+		LifecycleVersion:  builderImage.LifecycleVersion,
+		LatestImage:       builderImage.Identifier,
+		RunImage:          builderImage.RunImage,
+		PreviousImage:     previousImage,
+		ObservedRevisions: revisions,
+	}
+	return customBuilder, nil
+}
+
+// failedStatus keeps the previous transition time when the failure is unchanged
+// so that retries do not generate status updates that requeue the builder.
+func failedStatus(customBuilder *v1alpha1.CustomBuilder, err error) v1alpha1.BuilderStatus {
+	transitionTime := apis.VolatileTime{Inner: metav1.Now()}
+	if previous := customBuilder.Status.GetCondition(duckv1alpha1.ConditionReady); previous != nil &&
+		previous.Status == corev1.ConditionFalse && previous.Message == err.Error() {
+		transitionTime = previous.LastTransitionTime
+	}
+
+	return v1alpha1.BuilderStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: customBuilder.Generation,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionReady,
+					Status:             corev1.ConditionFalse,
+					Message:            err.Error(),
+					LastTransitionTime: transitionTime,
+				},
+			},
+		},
+	}
+}
+
+func transform(in cnb.BuilderMetadata) v1alpha1.BuildpackMetadataList {
+	out := make(v1alpha1.BuildpackMetadataList, 0, len(in))
+
+	for _, m := range in {
+		out = append(out, v1alpha1.BuildpackMetadata{
+			ID:       m.ID,
+			Version:  m.Version,
+			Homepage: m.Homepage,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,407 @@
+package custombuilder_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/custombuilder"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/custombuilder/custombuilderfakes"
+)
+
+func TestCustomBuilderReconciler(t *testing.T) {
+	spec.Run(t, "Custom Builder Reconciler", testCustomBuilderReconciler)
+}
+
+func testCustomBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
+	fakeBuilderCreator := &custombuilderfakes.FakeBuilderCreator{}
+	fakeMetadataRetriever := &custombuilderfakes.FakeMetadataRetriever{}
+	fakeEnqueuer := &custombuilderfakes.FakeEnqueuer{}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList, reporter *rtesting.FakeStatsReporter) {
+			listers := testhelpers.NewListers(row.Objects)
+
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+
+			eventRecorder := record.NewFakeRecorder(10)
+			actionRecorderList := rtesting.ActionRecorderList{fakeClient}
+			eventList := rtesting.EventList{Recorder: eventRecorder}
+			r := &custombuilder.Reconciler{
+				Client:              fakeClient,
+				CustomBuilderLister: listers.GetCustomBuilderLister(),
+				BuilderCreator:      fakeBuilderCreator,
+				MetadataRetriever:   fakeMetadataRetriever,
+				Enqueuer:            fakeEnqueuer,
+			}
+
+			return r, actionRecorderList, eventList, &rtesting.FakeStatsReporter{}
+		})
+
+	const (
+		builderName             = "custom-builder"
+		namespace               = "some-namespace"
+		key                     = "some-namespace/custom-builder"
+		builderTag              = "some/custom-builder"
+		builderIdentifier       = "some/custom-builder@sha256:resolved-builder-digest"
+		runImgIdentifier        = "some/run@sha256:resolved-run-digest"
+		initialGeneration int64 = 1
+	)
+
+	revisions := []string{
+		"some/build@sha256:build-digest",
+		"some/lifecycle@sha256:lifecycle-digest",
+		"some/store@sha256:store-digest",
+	}
+	fakeBuilderCreator.RevisionsReturns(revisions, nil)
+
+	customBuilder := &v1alpha1.CustomBuilder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       builderName,
+			Namespace:  namespace,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.CustomBuilderSpec{
+			Tag: builderTag,
+			Stack: v1alpha1.CustomStack{
+				ID:         "io.buildpacks.stacks.bionic",
+				BuildImage: "some/build",
+				RunImage:   "some/run",
+			},
+			Store: []v1alpha1.StoreImage{
+				{Image: "some/store"},
+			},
+			Order: []v1alpha1.OrderEntry{
+				{
+					Group: []v1alpha1.BuildpackRef{
+						{ID: "buildpack.id"},
+					},
+				},
+			},
+			ServiceAccount: "some-sa",
+		},
+	}
+
+	readyStatus := v1alpha1.BuilderStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: initialGeneration,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:   duckv1alpha1.ConditionReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+		BuilderMetadata: v1alpha1.BuildpackMetadataList{
+			{
+				ID:      "buildpack.id",
+				Version: "1.0.0",
+			},
+		},
+		LatestImage:       builderIdentifier,
+		RunImage:          runImgIdentifier,
+		ObservedRevisions: revisions,
+	}
+
+	when("#Reconcile", func() {
+		when("the builder can be created", func() {
+			fakeBuilderCreator.CreateBuilderReturns(builderIdentifier, nil)
+			fakeMetadataRetriever.GetBuilderImageReturns(cnb.BuilderImage{
+				BuilderBuildpackMetadata: cnb.BuilderMetadata{
+					{
+						ID:      "buildpack.id",
+						Version: "1.0.0",
+					},
+				},
+				Identifier: builderIdentifier,
+				RunImage:   runImgIdentifier,
+			}, nil)
+
+			it("pushes the builder and saves its metadata to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{customBuilder},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: customBuilder.ObjectMeta,
+								Spec:       customBuilder.Spec,
+								Status:     readyStatus,
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeBuilderCreator.CreateBuilderCallCount())
+				assert.Equal(t, customBuilder.Spec, fakeBuilderCreator.CreateBuilderArgsForCall(0).Spec)
+
+				require.Equal(t, 1, fakeMetadataRetriever.GetBuilderImageCallCount())
+				builderResource := fakeMetadataRetriever.GetBuilderImageArgsForCall(0)
+				assert.Equal(t, builderIdentifier, builderResource.Image())
+				assert.Equal(t, "some-sa", builderResource.ServiceAccount())
+
+				require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("does not recreate a builder that is ready", func() {
+				readyBuilder := customBuilder.DeepCopy()
+				readyBuilder.Status = readyStatus
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{readyBuilder},
+					WantErr: false,
+				})
+
+				assert.Equal(t, 0, fakeBuilderCreator.CreateBuilderCallCount())
+				require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+				assert.Equal(t, builderName, fakeEnqueuer.EnqueueArgsForCall(0).Name)
+			})
+
+			it("reassembles the builder when the stack or store images move", func() {
+				movedBuilder := customBuilder.DeepCopy()
+				movedBuilder.Status = *readyStatus.DeepCopy()
+				movedBuilder.Status.LatestImage = "some/custom-builder@sha256:previous-builder-digest"
+				movedBuilder.Status.ObservedRevisions = []string{
+					"some/build@sha256:build-digest",
+					"some/lifecycle@sha256:lifecycle-digest",
+					"some/store@sha256:previous-store-digest",
+				}
+
+				expectedStatus := *readyStatus.DeepCopy()
+				expectedStatus.PreviousImage = "some/custom-builder@sha256:previous-builder-digest"
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{movedBuilder},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: movedBuilder.ObjectMeta,
+								Spec:       movedBuilder.Spec,
+								Status:     expectedStatus,
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 1, fakeBuilderCreator.CreateBuilderCallCount())
+				require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("keeps a ready builder when its images cannot be resolved", func() {
+				fakeBuilderCreator.RevisionsReturns(nil, errors.New("registry unavailable"))
+
+				readyBuilder := customBuilder.DeepCopy()
+				readyBuilder.Status = readyStatus
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{readyBuilder},
+					WantErr: true,
+				})
+
+				assert.Equal(t, 0, fakeBuilderCreator.CreateBuilderCallCount())
+			})
+
+			it("recreates the builder when the spec changes", func() {
+				updatedBuilder := customBuilder.DeepCopy()
+				updatedBuilder.Status = readyStatus
+				updatedBuilder.Generation = 2
+
+				expectedStatus := *readyStatus.DeepCopy()
+				expectedStatus.ObservedGeneration = 2
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{updatedBuilder},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: updatedBuilder.ObjectMeta,
+								Spec:       updatedBuilder.Spec,
+								Status:     expectedStatus,
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 1, fakeBuilderCreator.CreateBuilderCallCount())
+			})
+
+			it("records the previous builder image when the recreated builder has a new digest", func() {
+				updatedBuilder := customBuilder.DeepCopy()
+				updatedBuilder.Status = *readyStatus.DeepCopy()
+				updatedBuilder.Status.LatestImage = "some/custom-builder@sha256:previous-builder-digest"
+				updatedBuilder.Generation = 2
+
+				expectedStatus := *readyStatus.DeepCopy()
+				expectedStatus.ObservedGeneration = 2
+				expectedStatus.PreviousImage = "some/custom-builder@sha256:previous-builder-digest"
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{updatedBuilder},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: updatedBuilder.ObjectMeta,
+								Spec:       updatedBuilder.Spec,
+								Status:     expectedStatus,
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("the builder cannot be created", func() {
+			fakeBuilderCreator.CreateBuilderReturns("", errors.New("buildpack some.id not found in store"))
+
+			it("saves not ready to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{customBuilder},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: customBuilder.ObjectMeta,
+								Spec:       customBuilder.Spec,
+								Status: v1alpha1.BuilderStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: initialGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Message: "buildpack some.id not found in store",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeMetadataRetriever.GetBuilderImageCallCount())
+			})
+
+			it("does not update the status when the failure is unchanged", func() {
+				failedBuilder := customBuilder.DeepCopy()
+				failedBuilder.Status = v1alpha1.BuilderStatus{
+					Status: duckv1alpha1.Status{
+						ObservedGeneration: initialGeneration,
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:    duckv1alpha1.ConditionReady,
+								Status:  corev1.ConditionFalse,
+								Message: "buildpack some.id not found in store",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{failedBuilder},
+					WantErr: true,
+				})
+			})
+		})
+
+		when("the builder images cannot be resolved", func() {
+			fakeBuilderCreator.RevisionsReturns(nil, errors.New("some/store not found"))
+
+			it("saves not ready to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{customBuilder},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: customBuilder.ObjectMeta,
+								Spec:       customBuilder.Spec,
+								Status: v1alpha1.BuilderStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: initialGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Message: "some/store not found",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeBuilderCreator.CreateBuilderCallCount())
+			})
+		})
+
+		when("the pushed builder metadata cannot be read", func() {
+			fakeBuilderCreator.CreateBuilderReturns(builderIdentifier, nil)
+			fakeMetadataRetriever.GetBuilderImageReturns(cnb.BuilderImage{}, errors.New("unavailable metadata"))
+
+			it("saves not ready to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{customBuilder},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.CustomBuilder{
+								ObjectMeta: customBuilder.ObjectMeta,
+								Spec:       customBuilder.Spec,
+								Status: v1alpha1.BuilderStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: initialGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Message: "unavailable metadata",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+
+		it("does not return error on nonexistent builder", func() {
+			rt.Test(rtesting.TableRow{
+				Key:     key,
+				WantErr: false,
+			})
+		})
+	})
+}
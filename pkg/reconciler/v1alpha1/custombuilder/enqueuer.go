@@ -0,0 +1,17 @@
+package custombuilder
+
+import (
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type workQueueEnqueuer struct {
+	enqueueAfter func(obj interface{}, after time.Duration)
+	delay        time.Duration
+}
+
+func (e *workQueueEnqueuer) Enqueue(customBuilder *v1alpha1.CustomBuilder) error {
+	e.enqueueAfter(customBuilder, e.delay)
+	return nil
+}
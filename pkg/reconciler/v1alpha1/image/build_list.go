@@ -3,12 +3,15 @@ package image
 import (
 	"sort"
 
+	"github.com/google/go-containerregistry/pkg/name"
+
 	v1alpha1build "github.com/pivotal/kpack/pkg/reconciler/v1alpha1/build"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 )
 
 type buildList struct {
+	builds           []*v1alpha1.Build
 	successfulBuilds []*v1alpha1.Build
 	failedBuilds     []*v1alpha1.Build
 	lastBuild        *v1alpha1.Build
@@ -17,7 +20,7 @@ type buildList struct {
 func newBuildList(builds []*v1alpha1.Build) (buildList, error) {
 	sort.Sort(v1alpha1build.ByCreationTimestamp(builds)) //nobody enforcing this
 
-	buildList := buildList{}
+	buildList := buildList{builds: builds}
 
 	for _, build := range builds {
 		if build.IsSuccess() {
@@ -34,18 +37,118 @@ func newBuildList(builds []*v1alpha1.Build) (buildList, error) {
 	return buildList, nil
 }
 
-func (l buildList) NumberFailedBuilds() int64 {
-	return int64(len(l.failedBuilds))
+// ExcessBuilds returns the oldest failed and successful builds exceeding the limits.
+func (l buildList) ExcessBuilds(failedLimit, successLimit int64) []*v1alpha1.Build {
+	return append(excess(l.failedBuilds, failedLimit), excess(l.successfulBuilds, successLimit)...)
 }
 
-func (l buildList) OldestFailure() *v1alpha1.Build {
-	return l.failedBuilds[0]
+func excess(builds []*v1alpha1.Build, limit int64) []*v1alpha1.Build {
+	if int64(len(builds)) <= limit {
+		return nil
+	}
+	return builds[:int64(len(builds))-limit]
 }
 
-func (l buildList) NumberSuccessfulBuilds() int64 {
-	return int64(len(l.successfulBuilds))
+// UnreferencedImages returns the images of the removed builds that are not
+// an image of any other build.
+func (l buildList) UnreferencedImages(removed []*v1alpha1.Build) []string {
+	isRemoved := map[*v1alpha1.Build]bool{}
+	for _, build := range removed {
+		isRemoved[build] = true
+	}
+
+	referenced := map[string]bool{}
+	for _, build := range l.builds {
+		if isRemoved[build] {
+			continue
+		}
+		for _, image := range builtImages(build) {
+			referenced[image] = true
+		}
+	}
+
+	var images []string
+	for _, build := range removed {
+		for _, image := range builtImages(build) {
+			if referenced[image] {
+				continue
+			}
+			referenced[image] = true
+			images = append(images, image)
+		}
+	}
+	return images
 }
 
-func (l buildList) OldestSuccess() *v1alpha1.Build {
-	return l.successfulBuilds[0]
+// RemovableTags returns the tags pushed by the removed builds that no other
+// build pushed.
+func (l buildList) RemovableTags(removed []*v1alpha1.Build) []string {
+	isRemoved := map[*v1alpha1.Build]bool{}
+	for _, build := range removed {
+		isRemoved[build] = true
+	}
+
+	pushed := map[string]bool{}
+	for _, build := range l.builds {
+		if isRemoved[build] {
+			continue
+		}
+		for _, pushedTag := range build.Status.PushedTags {
+			pushed[pushedTag.Tag] = true
+		}
+	}
+
+	var tags []string
+	for _, build := range removed {
+		for _, pushedTag := range build.Status.PushedTags {
+			if pushed[pushedTag.Tag] {
+				continue
+			}
+			pushed[pushedTag.Tag] = true
+			tags = append(tags, pushedTag.Tag)
+		}
+	}
+	return tags
+}
+
+func builtImages(build *v1alpha1.Build) []string {
+	var images []string
+	if build.Status.LatestImage != "" {
+		images = append(images, normalizedImage(build.Status.LatestImage))
+	}
+	if build.Status.ExportedImage != "" {
+		images = append(images, normalizedImage(build.Status.ExportedImage))
+	}
+	for _, pushedTag := range build.Status.PushedTags {
+		tag, err := name.NewTag(pushedTag.Tag, name.WeakValidation)
+		if err != nil || pushedTag.Digest == "" {
+			continue
+		}
+		images = append(images, normalizedImage(tag.Context().Name()+"@"+pushedTag.Digest))
+	}
+	for _, platform := range build.Status.Platforms {
+		if platform.LatestImage != "" {
+			images = append(images, normalizedImage(platform.LatestImage))
+		}
+		if platform.ExportedImage != "" {
+			images = append(images, normalizedImage(platform.ExportedImage))
+		}
+	}
+	return images
+}
+
+func normalizedImage(image string) string {
+	digest, err := name.NewDigest(image, name.WeakValidation)
+	if err != nil {
+		return image
+	}
+	return digest.Context().Name() + "@" + digest.DigestStr()
+}
+
+func imageDigest(image string) string {
+	digest, err := name.NewDigest(image, name.WeakValidation)
+	if err != nil {
+		return image
+	}
+	return digest.DigestStr()
 }
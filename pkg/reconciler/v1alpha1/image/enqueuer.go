@@ -0,0 +1,17 @@
+package image
+
+import (
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type workQueueEnqueuer struct {
+	enqueueAfter func(obj interface{}, after time.Duration)
+	delay        time.Duration
+}
+
+func (e *workQueueEnqueuer) Enqueue(image *v1alpha1.Image) error {
+	e.enqueueAfter(image, e.delay)
+	return nil
+}
@@ -0,0 +1,31 @@
+package image
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestEnqueueAfter(t *testing.T) {
+	image := &v1alpha1.Image{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "name",
+			Namespace: "namespace",
+		},
+	}
+
+	enqueuer := &workQueueEnqueuer{
+		delay: time.Minute,
+		enqueueAfter: func(obj interface{}, after time.Duration) {
+			require.Equal(t, image, obj)
+			require.Equal(t, after, time.Minute)
+		},
+	}
+
+	err := enqueuer.Enqueue(image)
+	require.NoError(t, err)
+}
@@ -16,6 +16,7 @@ import (
 	k8sclient "k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
 	"knative.dev/pkg/controller"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -23,13 +24,13 @@ import (
 	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
 	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
 	"github.com/pivotal/kpack/pkg/tracker"
 )
 
 const (
-	ReconcilerName           = "Images"
-	Kind                     = "Image"
-	buildHistoryDefaultLimit = 10
+	ReconcilerName = "Images"
+	Kind           = "Image"
 )
 
 type Tracker interface {
@@ -37,27 +38,65 @@ type Tracker interface {
 	OnChanged(obj interface{})
 }
 
+//go:generate counterfeiter . RunImageResolver
+type RunImageResolver interface {
+	Resolve(image *v1alpha1.Image) (string, error)
+}
+
+//go:generate counterfeiter . ImageDeleter
+type ImageDeleter interface {
+	Delete(images []string, removableTags []string, secretRef registry.SecretRef) error
+}
+
+//go:generate counterfeiter . RegistryAccessChecker
+type RegistryAccessChecker interface {
+	HasWriteAccess(tag string, secretRef registry.SecretRef) (bool, error)
+}
+
+//go:generate counterfeiter . Enqueuer
+type Enqueuer interface {
+	Enqueue(image *v1alpha1.Image) error
+}
+
 func NewController(opt reconciler.Options,
 	k8sClient k8sclient.Interface,
 	imageInformer v1alpha1informers.ImageInformer,
 	buildInformer v1alpha1informers.BuildInformer,
 	builderInformer v1alpha1informers.BuilderInformer,
 	clusterBuilderInformer v1alpha1informers.ClusterBuilderInformer,
+	customBuilderInformer v1alpha1informers.CustomBuilderInformer,
 	sourceResolverInformer v1alpha1informers.SourceResolverInformer,
-	pvcInformer coreinformers.PersistentVolumeClaimInformer) *controller.Impl {
+	stackInformer v1alpha1informers.StackInformer,
+	imagePromotionInformer v1alpha1informers.ImagePromotionInformer,
+	pvcInformer coreinformers.PersistentVolumeClaimInformer,
+	runImageResolver RunImageResolver,
+	imageDeleter ImageDeleter,
+	registryAccessChecker RegistryAccessChecker) *controller.Impl {
 	c := &Reconciler{
-		Client:               opt.Client,
-		K8sClient:            k8sClient,
-		ImageLister:          imageInformer.Lister(),
-		BuildLister:          buildInformer.Lister(),
-		BuilderLister:        builderInformer.Lister(),
-		ClusterBuilderLister: clusterBuilderInformer.Lister(),
-		SourceResolverLister: sourceResolverInformer.Lister(),
-		PvcLister:            pvcInformer.Lister(),
+		Client:                opt.Client,
+		K8sClient:             k8sClient,
+		ImageLister:           imageInformer.Lister(),
+		BuildLister:           buildInformer.Lister(),
+		BuilderLister:         builderInformer.Lister(),
+		ClusterBuilderLister:  clusterBuilderInformer.Lister(),
+		CustomBuilderLister:   customBuilderInformer.Lister(),
+		SourceResolverLister:  sourceResolverInformer.Lister(),
+		StackLister:           stackInformer.Lister(),
+		ImagePromotionLister:  imagePromotionInformer.Lister(),
+		PvcLister:             pvcInformer.Lister(),
+		RunImageResolver:      runImageResolver,
+		ImageDeleter:          imageDeleter,
+		RegistryAccessChecker: registryAccessChecker,
+		RebuildPolicies:       []v1alpha1.RebuildPolicy{v1alpha1.VulnerabilityRebuildPolicy{}},
 	}
 
 	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
 
+	c.Enqueuer = &workQueueEnqueuer{
+		enqueueAfter: impl.EnqueueAfter,
+		delay:        opt.BuilderPollingFrequency,
+	}
+
 	imageInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
 
 	buildInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -87,19 +126,37 @@ func NewController(opt reconciler.Options,
 		(&v1alpha1.ClusterBuilder{}).GetGroupVersionKind(),
 	)))
 
+	customBuilderInformer.Informer().AddEventHandler(reconciler.Handler(controller.EnsureTypeMeta(
+		c.Tracker.OnChanged,
+		(&v1alpha1.CustomBuilder{}).GetGroupVersionKind(),
+	)))
+
+	stackInformer.Informer().AddEventHandler(reconciler.Handler(controller.EnsureTypeMeta(
+		c.Tracker.OnChanged,
+		(&v1alpha1.Stack{}).GetGroupVersionKind(),
+	)))
+
 	return impl
 }
 
 type Reconciler struct {
-	Client               versioned.Interface
-	ImageLister          v1alpha1Listers.ImageLister
-	BuildLister          v1alpha1Listers.BuildLister
-	BuilderLister        v1alpha1Listers.BuilderLister
-	ClusterBuilderLister v1alpha1Listers.ClusterBuilderLister
-	SourceResolverLister v1alpha1Listers.SourceResolverLister
-	PvcLister            corelisters.PersistentVolumeClaimLister
-	Tracker              Tracker
-	K8sClient            k8sclient.Interface
+	Client                versioned.Interface
+	ImageLister           v1alpha1Listers.ImageLister
+	BuildLister           v1alpha1Listers.BuildLister
+	BuilderLister         v1alpha1Listers.BuilderLister
+	ClusterBuilderLister  v1alpha1Listers.ClusterBuilderLister
+	CustomBuilderLister   v1alpha1Listers.CustomBuilderLister
+	SourceResolverLister  v1alpha1Listers.SourceResolverLister
+	StackLister           v1alpha1Listers.StackLister
+	ImagePromotionLister  v1alpha1Listers.ImagePromotionLister
+	PvcLister             corelisters.PersistentVolumeClaimLister
+	Tracker               Tracker
+	K8sClient             k8sclient.Interface
+	RunImageResolver      RunImageResolver
+	ImageDeleter          ImageDeleter
+	RegistryAccessChecker RegistryAccessChecker
+	RebuildPolicies       []v1alpha1.RebuildPolicy
+	Enqueuer              Enqueuer
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -120,7 +177,21 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 		return err
 	}
 
-	return c.updateStatus(image)
+	err = c.updateStatus(image)
+	if err != nil {
+		return err
+	}
+
+	// denied access is rechecked with the backoff of the work queue
+	if condition := image.Status.GetCondition(v1alpha1.ConditionRegistryAccessReady); condition.IsFalse() {
+		return errors.New(condition.Message)
+	}
+
+	// an explicit run image is a tag, poll it so that new digests trigger a rebase
+	if image.Spec.RunImage != nil && image.Spec.RunImage.Image != "" {
+		return c.Enqueuer.Enqueue(image)
+	}
+	return nil
 }
 
 func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, error) {
@@ -157,9 +228,35 @@ func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, err
 		return nil, err
 	}
 
-	buildApplier, err := image.ReconcileBuild(lastBuild, sourceResolver, builder)
+	image.Status.RunImage, err = c.reconcileRunImage(image)
+	if err == errStackNotReady {
+		image.Status.Conditions = image.StackNotReady()
+		image.Status.ObservedGeneration = image.Generation
+		return image, nil
+	} else if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	} else if k8serrors.IsNotFound(err) {
+		image.Status.Conditions = image.StackNotFound()
+		image.Status.ObservedGeneration = image.Generation
+		return image, nil
+	}
+
+	deniedTag, err := c.reconcileRegistryAccess(image)
 	if err != nil {
 		return nil, err
+	} else if deniedTag != "" {
+		image.Status.Conditions = image.RegistryAccessDenied(deniedTag)
+		image.Status.ObservedGeneration = image.Generation
+		return image, nil
+	}
+
+	buildApplier, err := image.ReconcileBuild(lastBuild, sourceResolver, builder, c.RebuildPolicies...)
+	if tagTemplateErr, ok := errors.Cause(err).(*v1alpha1.TagTemplateError); ok {
+		image.Status.Conditions = append(image.TagTemplateFailed(tagTemplateErr), image.RegistryAccessReady())
+		image.Status.ObservedGeneration = image.Generation
+		return image, nil
+	} else if err != nil {
+		return nil, err
 	}
 
 	reconciledBuild, err := buildApplier.Apply(c)
@@ -170,7 +267,8 @@ func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, err
 	image.Status.LatestBuildRef = reconciledBuild.Build.BuildRef()
 	image.Status.BuildCounter = reconciledBuild.BuildCounter
 	image.Status.LatestImage = reconciledBuild.LatestImage
-	image.Status.Conditions = reconciledBuild.Conditions
+	image.Status.BuildCacheSize = reconciledBuild.CacheSize
+	image.Status.Conditions = append(reconciledBuild.Conditions, image.RegistryAccessReady())
 	image.Status.ObservedGeneration = image.Generation
 
 	return image, c.deleteOldBuilds(image)
@@ -179,12 +277,18 @@ func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, err
 func (c *Reconciler) getBuilder(image *v1alpha1.Image) (v1alpha1.BuilderResource, error) {
 	var builder v1alpha1.BuilderResource
 	var err error
-	if image.Spec.Builder.Kind == v1alpha1.ClusterBuilderKind {
+	switch image.Spec.Builder.Kind {
+	case v1alpha1.ClusterBuilderKind:
 		builder, err = c.ClusterBuilderLister.Get(image.Spec.Builder.Name)
 		if err != nil && !k8serrors.IsNotFound(err) {
 			return nil, errors.Wrap(err, "cannot retrieve cluster builder")
 		}
-	} else {
+	case v1alpha1.CustomBuilderKind:
+		builder, err = c.CustomBuilderLister.CustomBuilders(image.Namespace).Get(image.Spec.Builder.Name)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, errors.Wrap(err, "cannot retrieve custom builder")
+		}
+	default:
 		builder, err = c.BuilderLister.Builders(image.Namespace).Get(image.Spec.Builder.Name)
 		if err != nil && !k8serrors.IsNotFound(err) {
 			return nil, errors.Wrap(err, "cannot retrieve namespaced builder")
@@ -193,6 +297,66 @@ func (c *Reconciler) getBuilder(image *v1alpha1.Image) (v1alpha1.BuilderResource
 	return builder, err
 }
 
+// reconcileRegistryAccess returns the first destination tag the service account
+// of the image cannot push to. Access is verified once per generation of the
+// image so that a misconfigured service account does not fail every build.
+func (c *Reconciler) reconcileRegistryAccess(image *v1alpha1.Image) (string, error) {
+	if image.RegistryAccessVerified() {
+		return "", nil
+	}
+
+	secretRef := registry.SecretRef{
+		ServiceAccount: image.Spec.ServiceAccount,
+		Namespace:      image.Namespace,
+	}
+	for _, tag := range image.DestinationTags() {
+		hasWriteAccess, err := c.RegistryAccessChecker.HasWriteAccess(tag, secretRef)
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot verify write access to %s", tag)
+		}
+
+		if !hasWriteAccess {
+			return tag, nil
+		}
+	}
+	return "", nil
+}
+
+// errStackNotReady keeps builds from starting until the stack selected for the
+// run image is resolved. The stack is tracked, so the image is reconciled
+// again once the stack becomes ready.
+var errStackNotReady = errors.New("stack is not ready")
+
+// reconcileRunImage resolves the run image selected by the image. Builds use the
+// run image of the builder when the image does not select one.
+func (c *Reconciler) reconcileRunImage(image *v1alpha1.Image) (string, error) {
+	if image.Spec.RunImage == nil {
+		return "", nil
+	}
+
+	if image.Spec.RunImage.Stack != "" {
+		stack, err := c.StackLister.Get(image.Spec.RunImage.Stack)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return "", errors.Wrap(err, "cannot retrieve stack")
+		} else if k8serrors.IsNotFound(err) {
+			return "", err
+		}
+
+		err = c.Tracker.Track(stack, image.NamespacedName())
+		if err != nil {
+			return "", err
+		}
+
+		if !stack.Status.GetCondition(duckv1alpha1.ConditionReady).IsTrue() {
+			return "", errStackNotReady
+		}
+		return stack.Status.RunImage.LatestImage, nil
+	}
+
+	runImage, err := c.RunImageResolver.Resolve(image)
+	return runImage, errors.Wrap(err, "cannot resolve run image")
+}
+
 func (c *Reconciler) reconcileSourceResolver(image *v1alpha1.Image) (*v1alpha1.SourceResolver, error) {
 	desiredSourceResolver := image.SourceResolver()
 
@@ -242,10 +406,21 @@ func (c *Reconciler) reconcileBuildCache(image *v1alpha1.Image) (string, error)
 		}
 	}
 
+	if !buildCache.DeletionTimestamp.IsZero() {
+		return "", nil
+	}
+
 	if buildCacheEqual(desiredBuildCache, buildCache) {
 		return buildCache.Name, nil
 	}
 
+	// volume claims cannot shrink, a smaller cache is recreated once the old claim is removed
+	if buildCacheShrunk(desiredBuildCache, buildCache) {
+		return "", c.K8sClient.CoreV1().PersistentVolumeClaims(image.Namespace).Delete(buildCache.Name, &metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &buildCache.UID},
+		})
+	}
+
 	existing := buildCache.DeepCopy()
 	existing.Spec.Resources = desiredBuildCache.Spec.Resources
 	existing.ObjectMeta.Labels = desiredBuildCache.ObjectMeta.Labels
@@ -259,27 +434,79 @@ func (c *Reconciler) deleteOldBuilds(image *v1alpha1.Image) error {
 		return fmt.Errorf("failed fetching all builds for image: %s", err)
 	}
 
-	if builds.NumberFailedBuilds() > limitOrDefault(image.Spec.FailedBuildHistoryLimit, buildHistoryDefaultLimit) {
-		oldestFailedBuild := builds.OldestFailure()
+	oldBuilds := builds.ExcessBuilds(
+		limitOrDefault(image.Spec.FailedBuildHistoryLimit, v1alpha1.DefaultBuildHistoryLimit),
+		limitOrDefault(image.Spec.SuccessBuildHistoryLimit, v1alpha1.DefaultBuildHistoryLimit),
+	)
 
-		err := c.Client.BuildV1alpha1().Builds(image.Namespace).Delete(oldestFailedBuild.Name, &metav1.DeleteOptions{})
+	// images are deleted first so that failed deletes are retried with the builds still present
+	if image.Spec.Retention != nil && image.Spec.Retention.DeleteImages {
+		images, err := c.unpromotedImages(image, builds.UnreferencedImages(oldBuilds))
 		if err != nil {
-			return fmt.Errorf("failed deleting failed build: %s", err)
+			return err
 		}
-	}
 
-	if builds.NumberSuccessfulBuilds() > limitOrDefault(image.Spec.SuccessBuildHistoryLimit, buildHistoryDefaultLimit) {
-		oldestSuccess := builds.OldestSuccess()
+		if len(images) > 0 {
+			err = c.ImageDeleter.Delete(images, removableTags(image, builds.RemovableTags(oldBuilds)), registry.SecretRef{
+				ServiceAccount: image.Spec.ServiceAccount,
+				Namespace:      image.Namespace,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed deleting images of old builds")
+			}
+		}
+	}
 
-		err := c.Client.BuildV1alpha1().Builds(image.Namespace).Delete(oldestSuccess.Name, &metav1.DeleteOptions{})
-		if err != nil {
-			return fmt.Errorf("failed deleting successful build: %s", err)
+	for _, build := range oldBuilds {
+		err := c.Client.BuildV1alpha1().Builds(image.Namespace).Delete(build.Name, &metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed deleting build: %s", err)
 		}
 	}
 
 	return nil
 }
 
+// unpromotedImages filters the images promoted by an ImagePromotion.
+func (c *Reconciler) unpromotedImages(image *v1alpha1.Image, images []string) ([]string, error) {
+	promotions, err := c.ImagePromotionLister.ImagePromotions(image.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list image promotions")
+	}
+
+	promoted := map[string]bool{}
+	for _, promotion := range promotions {
+		if promotion.Status.PromotedImage != "" {
+			promoted[imageDigest(promotion.Status.PromotedImage)] = true
+		}
+	}
+
+	var unpromoted []string
+	for _, img := range images {
+		if !promoted[imageDigest(img)] {
+			unpromoted = append(unpromoted, img)
+		}
+	}
+	return unpromoted, nil
+}
+
+// removableTags never includes the tags of the image, they may still resolve
+// to an image of a removed build if no retained build pushed them.
+func removableTags(image *v1alpha1.Image, tags []string) []string {
+	imageTags := map[string]bool{image.Spec.Tag: true}
+	for _, tag := range image.Spec.AdditionalTags {
+		imageTags[tag] = true
+	}
+
+	var removable []string
+	for _, tag := range tags {
+		if !imageTags[tag] {
+			removable = append(removable, tag)
+		}
+	}
+	return removable
+}
+
 func limitOrDefault(limit *int64, defaultLimit int64) int64 {
 	if limit != nil {
 		return *limit
@@ -334,6 +561,11 @@ func buildCacheEqual(desiredBuildCache *corev1.PersistentVolumeClaim, buildCache
 		equality.Semantic.DeepEqual(desiredBuildCache.Labels, buildCache.Labels)
 }
 
+func buildCacheShrunk(desiredBuildCache *corev1.PersistentVolumeClaim, buildCache *corev1.PersistentVolumeClaim) bool {
+	desiredSize := desiredBuildCache.Spec.Resources.Requests[corev1.ResourceStorage]
+	return desiredSize.Cmp(buildCache.Spec.Resources.Requests[corev1.ResourceStorage]) < 0
+}
+
 func (c *Reconciler) CreateBuild(build *v1alpha1.Build) (*v1alpha1.Build, error) {
 	return c.Client.BuildV1alpha1().Builds(build.Namespace).Create(build)
 }
@@ -1,12 +1,14 @@
 package image_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -26,6 +28,8 @@ import (
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
 	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/image"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/image/imagefakes"
+	"github.com/pivotal/kpack/pkg/registry"
 )
 
 func TestImageReconciler(t *testing.T) {
@@ -46,7 +50,12 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 		originalGeneration     int64 = 0
 	)
 	var (
-		fakeTracker = fakeTracker{}
+		fakeTracker          = fakeTracker{}
+		fakeRunImageResolver = &imagefakes.FakeRunImageResolver{}
+		fakeEnqueuer         = &imagefakes.FakeEnqueuer{}
+		fakeImageDeleter     = &imagefakes.FakeImageDeleter{}
+
+		fakeRegistryAccessChecker = &imagefakes.FakeRegistryAccessChecker{}
 	)
 
 	rt := testhelpers.ReconcilerTester(t,
@@ -61,15 +70,23 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			eventList := rtesting.EventList{Recorder: eventRecorder}
 
 			r := &image.Reconciler{
-				Client:               fakeClient,
-				ImageLister:          listers.GetImageLister(),
-				BuildLister:          listers.GetBuildLister(),
-				BuilderLister:        listers.GetBuilderLister(),
-				ClusterBuilderLister: listers.GetClusterBuilderLister(),
-				SourceResolverLister: listers.GetSourceResolverLister(),
-				PvcLister:            listers.GetPersistentVolumeClaimLister(),
-				Tracker:              fakeTracker,
-				K8sClient:            k8sfakeClient,
+				Client:                fakeClient,
+				ImageLister:           listers.GetImageLister(),
+				BuildLister:           listers.GetBuildLister(),
+				BuilderLister:         listers.GetBuilderLister(),
+				ClusterBuilderLister:  listers.GetClusterBuilderLister(),
+				CustomBuilderLister:   listers.GetCustomBuilderLister(),
+				SourceResolverLister:  listers.GetSourceResolverLister(),
+				StackLister:           listers.GetStackLister(),
+				ImagePromotionLister:  listers.GetImagePromotionLister(),
+				PvcLister:             listers.GetPersistentVolumeClaimLister(),
+				Tracker:               fakeTracker,
+				K8sClient:             k8sfakeClient,
+				RunImageResolver:      fakeRunImageResolver,
+				ImageDeleter:          fakeImageDeleter,
+				RegistryAccessChecker: fakeRegistryAccessChecker,
+				RebuildPolicies:       []v1alpha1.RebuildPolicy{v1alpha1.VulnerabilityRebuildPolicy{}},
+				Enqueuer:              fakeEnqueuer,
 			}
 
 			rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
@@ -167,6 +184,10 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 	}
 
 	when("Reconcile", func() {
+		it.Before(func() {
+			fakeRegistryAccessChecker.HasWriteAccessReturns(true, nil)
+		})
+
 		it("updates observed generation after processing an update", func() {
 			const updatedGeneration int64 = 1
 			image.ObjectMeta.Generation = updatedGeneration
@@ -225,6 +246,37 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			require.True(t, fakeTracker.IsTracking(builder, image.NamespacedName()))
 		})
 
+		it("tracks custom builder for image", func() {
+			customBuilder := &v1alpha1.CustomBuilder{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "custom-builder-name",
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.CustomBuilderSpec{
+					Tag: "some/custom-builder",
+				},
+				Status: builder.Status,
+			}
+			image.Spec.Builder = v1alpha1.ImageBuilder{
+				TypeMeta: v1.TypeMeta{
+					Kind: v1alpha1.CustomBuilderKind,
+				},
+				Name: customBuilder.Name,
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: []runtime.Object{
+					image,
+					customBuilder,
+					unresolvedSourceResolver(image),
+				},
+				WantErr: false,
+			})
+
+			require.True(t, fakeTracker.IsTracking(customBuilder, image.NamespacedName()))
+		})
+
 		it("sets condition not ready for non-existent builder", func() {
 			rt.Test(rtesting.TableRow{
 				Key: key,
@@ -517,42 +569,561 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
-			it("updates build cache if desired labels change", func() {
-				var imageCacheName = image.CacheName()
-				image.Spec.CacheSize = &cacheSize
-				image.Status.BuildCacheName = imageCacheName
-				cache := image.BuildCache()
+			it("deletes the build cache to recreate it when the cache size shrinks", func() {
+				largerCacheSize := resource.MustParse("2.5")
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				cache := image.BuildCache()
+				cache.Spec.Resources.Requests[corev1.ResourceStorage] = largerCacheSize
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						builder,
+						cache,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							Name: image.CacheName(),
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("waits for a deleted build cache to be removed before recreating it", func() {
+				image.Spec.CacheSize = &cacheSize
+				cache := image.BuildCache()
+				cache.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						builder,
+						cache,
+					},
+					WantErr: false,
+				})
+			})
+
+			it("updates build cache if desired labels change", func() {
+				var imageCacheName = image.CacheName()
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = imageCacheName
+				cache := image.BuildCache()
+
+				extraLabelImage := image.DeepCopy()
+				extraLabelImage.Labels["another/label"] = "label"
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						extraLabelImage,
+						extraLabelImage.SourceResolver(),
+						builder,
+						cache,
+					},
+					WantErr: false,
+					WantUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &corev1.PersistentVolumeClaim{
+								ObjectMeta: v1.ObjectMeta{
+									Name: imageCacheName,
+									OwnerReferences: []metav1.OwnerReference{
+										*kmeta.NewControllerRef(image),
+									},
+									Namespace: namespace,
+									Labels: map[string]string{
+										someLabelKey:    someValueToPassThrough,
+										"another/label": "label",
+									},
+								},
+								Spec: corev1.PersistentVolumeClaimSpec{
+									AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+									Resources: corev1.ResourceRequirements{
+										Requests: corev1.ResourceList{
+											corev1.ResourceStorage: cacheSize,
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("deletes a cache if already exists and not requested", func() {
+				image.Status.BuildCacheName = image.CacheName()
+				image.Spec.CacheSize = nil
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image.SourceResolver(),
+						&corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      image.CacheName(),
+								Namespace: image.Namespace,
+							},
+						},
+						image,
+						builder,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							Name: image.CacheName(),
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+			it("reports the build cache size measured by the last successful build", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				image.Status.BuildCounter = 1
+				image.Status.LatestBuildRef = "image-name-build-1"
+
+				measuredSize := resource.MustParse("300Mi")
+				sourceResolver := resolvedSourceResolver(image)
+				lastBuilds := successfulBuilds(image, sourceResolver, 1)
+				lastBuilds[0].(*v1alpha1.Build).Status.CacheSize = &measuredSize
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: runtimeObjects(
+						lastBuilds,
+						image,
+						builder,
+						sourceResolver,
+						image.BuildCache(),
+					),
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReady(),
+									},
+									LatestBuildRef: "image-name-build-1",
+									LatestImage:    "some/image@sha256:build-1",
+									BuildCounter:   1,
+									BuildCacheName: image.CacheName(),
+									BuildCacheSize: &measuredSize,
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("reconciling run images", func() {
+			const (
+				stackName        = "some-stack"
+				stackRunImage    = "some/stack-run@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+				explicitRunImage = "some/explicit-run@sha256:c4e5e3ea177cd1238f67481d920ea17388792a0fb2cfa38fd95394f912c35ea8"
+			)
+
+			stack := &v1alpha1.Stack{
+				ObjectMeta: v1.ObjectMeta{
+					Name: stackName,
+					UID:  "stack-uid",
+				},
+				Spec: v1alpha1.StackSpec{
+					ID:       "io.buildpacks.stacks.bionic",
+					RunImage: v1alpha1.StackSpecImage{Image: "some/stack-run"},
+				},
+				Status: v1alpha1.StackStatus{
+					Status: duckv1alpha1.Status{
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+					ResolvedStack: v1alpha1.ResolvedStack{
+						RunImage: v1alpha1.StackStatusImage{
+							LatestImage: stackRunImage,
+							Image:       "some/stack-run",
+						},
+					},
+				},
+			}
+
+			it("tracks the stack and saves its run image to the status", func() {
+				image.Spec.RunImage = &v1alpha1.ImageRunImage{Stack: stackName}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						stack,
+						unresolvedSourceResolver(image),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									RunImage: stackRunImage,
+								},
+							},
+						},
+					},
+				})
+
+				require.True(t, fakeTracker.IsTracking(stack, image.NamespacedName()))
+				require.Equal(t, 0, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("sets condition not ready for non-existent stack", func() {
+				image.Spec.RunImage = &v1alpha1.ImageRunImage{Stack: stackName}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						unresolvedSourceResolver(image),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  "StackNotFound",
+												Message: "Unable to find stack some-stack.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("sets condition not ready while the stack is not ready", func() {
+				image.Spec.RunImage = &v1alpha1.ImageRunImage{Stack: stackName}
+				notReadyStack := stack.DeepCopy()
+				notReadyStack.Status = v1alpha1.StackStatus{
+					Status: duckv1alpha1.Status{
+						Conditions: duckv1alpha1.Conditions{
+							{
+								Type:   duckv1alpha1.ConditionReady,
+								Status: corev1.ConditionFalse,
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						notReadyStack,
+						unresolvedSourceResolver(image),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  "StackNotReady",
+												Message: "Stack some-stack is not ready.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+
+				require.True(t, fakeTracker.IsTracking(notReadyStack, image.NamespacedName()))
+			})
+
+			it("resolves an explicit run image and polls it for updates", func() {
+				image.Spec.RunImage = &v1alpha1.ImageRunImage{Image: "some/explicit-run"}
+				fakeRunImageResolver.ResolveReturns(explicitRunImage, nil)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						unresolvedSourceResolver(image),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									RunImage: explicitRunImage,
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeRunImageResolver.ResolveCallCount())
+				require.Equal(t, image.Spec.RunImage, fakeRunImageResolver.ResolveArgsForCall(0).Spec.RunImage)
+				require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("schedules a rebase when the run image of the stack has a new digest", func() {
+				image.Spec.RunImage = &v1alpha1.ImageRunImage{Stack: stackName}
+				image.Status.BuildCounter = 1
+				image.Status.LatestBuildRef = "image-name-build-1"
+				image.Status.LatestImage = "some/image@sha256:ad3f454c"
+				image.Status.RunImage = "some/stack-run@sha256:67e3de2af270bf09c02e9a644aeb7e87e6b3c049abe6766bf6b6c3728a83e7fb"
+				image.Status.Conditions = conditionReady()
+
+				sourceResolver := resolvedSourceResolver(image)
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						stack,
+						sourceResolver,
+						&v1alpha1.Build{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      image.Status.LatestBuildRef,
+								Namespace: namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(image),
+								},
+								Labels: map[string]string{
+									v1alpha1.BuildNumberLabel: "1",
+									v1alpha1.ImageLabel:       imageName,
+								},
+							},
+							Spec: v1alpha1.BuildSpec{
+								Tags:           []string{image.Spec.Tag},
+								Builder:        builder.BuildBuilderSpec(),
+								ServiceAccount: image.Spec.ServiceAccount,
+								Source: v1alpha1.SourceConfig{
+									Git: &v1alpha1.Git{
+										URL:      sourceResolver.Status.Source.Git.URL,
+										Revision: sourceResolver.Status.Source.Git.Revision,
+									},
+								},
+								RunImage: image.Status.RunImage,
+							},
+							Status: v1alpha1.BuildStatus{
+								LatestImage: image.Status.LatestImage,
+								RunImage:    image.Status.RunImage,
+								Status: duckv1alpha1.Status{
+									Conditions: duckv1alpha1.Conditions{
+										{
+											Type:   duckv1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+							},
+						},
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						&v1alpha1.Build{
+							ObjectMeta: metav1.ObjectMeta{
+								GenerateName: imageName + "-build-2-",
+								Namespace:    namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(image),
+								},
+								Labels: map[string]string{
+									v1alpha1.BuildNumberLabel: "2",
+									v1alpha1.ImageLabel:       imageName,
+									someLabelKey:              someValueToPassThrough,
+								},
+								Annotations: map[string]string{
+									v1alpha1.BuildReasonAnnotation: v1alpha1.BuildReasonStack,
+								},
+							},
+							Spec: v1alpha1.BuildSpec{
+								Tags:           []string{image.Spec.Tag},
+								Builder:        builder.BuildBuilderSpec(),
+								ServiceAccount: image.Spec.ServiceAccount,
+								Source: v1alpha1.SourceConfig{
+									Git: &v1alpha1.Git{
+										URL:      sourceResolver.Status.Source.Git.URL,
+										Revision: sourceResolver.Status.Source.Git.Revision,
+									},
+								},
+								LastBuild: v1alpha1.LastBuild{Image: image.Status.LatestImage},
+								RunImage:  stackRunImage,
+							},
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									LatestBuildRef: "image-name-build-2-00001", // GenerateNameReactor
+									LatestImage:    image.Status.LatestImage,
+									BuildCounter:   2,
+									RunImage:       stackRunImage,
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("reconciling registry access", func() {
+			secretRef := registry.SecretRef{
+				ServiceAccount: serviceAccount,
+				Namespace:      namespace,
+			}
+
+			it("verifies write access to every destination repository after an update", func() {
+				const updatedGeneration int64 = 1
+				image.ObjectMeta.Generation = updatedGeneration
+				image.Spec.AdditionalTags = []string{"other.registry.io/some/image", "other.registry.io/some/image:mirror"}
 
-				extraLabelImage := image.DeepCopy()
-				extraLabelImage.Labels["another/label"] = "label"
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						extraLabelImage,
-						extraLabelImage.SourceResolver(),
+						image,
 						builder,
-						cache,
+						unresolvedSourceResolver(image),
 					},
 					WantErr: false,
-					WantUpdates: []clientgotesting.UpdateActionImpl{
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
-							Object: &corev1.PersistentVolumeClaim{
-								ObjectMeta: v1.ObjectMeta{
-									Name: imageCacheName,
-									OwnerReferences: []metav1.OwnerReference{
-										*kmeta.NewControllerRef(image),
-									},
-									Namespace: namespace,
-									Labels: map[string]string{
-										someLabelKey:    someValueToPassThrough,
-										"another/label": "label",
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: updatedGeneration,
+										Conditions:         conditionReadyUnknown(),
 									},
 								},
-								Spec: corev1.PersistentVolumeClaimSpec{
-									AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
-									Resources: corev1.ResourceRequirements{
-										Requests: corev1.ResourceList{
-											corev1.ResourceStorage: cacheSize,
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 2, fakeRegistryAccessChecker.HasWriteAccessCallCount())
+				tag, ref := fakeRegistryAccessChecker.HasWriteAccessArgsForCall(0)
+				assert.Equal(t, "some/image", tag)
+				assert.Equal(t, secretRef, ref)
+				tag, ref = fakeRegistryAccessChecker.HasWriteAccessArgsForCall(1)
+				assert.Equal(t, "other.registry.io/some/image", tag)
+				assert.Equal(t, secretRef, ref)
+			})
+
+			it("does not schedule a build while write access is denied", func() {
+				const updatedGeneration int64 = 1
+				image.ObjectMeta.Generation = updatedGeneration
+				fakeRegistryAccessChecker.HasWriteAccessReturns(false, nil)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						resolvedSourceResolver(image),
+					},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: updatedGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.RegistryAccessDenied,
+												Message: "Service account service-account cannot push to some/image.",
+											},
+											{
+												Type:    v1alpha1.ConditionRegistryAccessReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.RegistryAccessDenied,
+												Message: "Service account service-account cannot push to some/image.",
+											},
 										},
 									},
 								},
@@ -562,29 +1133,17 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
-			it("deletes a cache if already exists and not requested", func() {
-				image.Status.BuildCacheName = image.CacheName()
-				image.Spec.CacheSize = nil
+			it("rechecks denied write access", func() {
+				image.Status.Conditions = image.RegistryAccessDenied(image.Spec.Tag)
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						image.SourceResolver(),
-						&corev1.PersistentVolumeClaim{
-							ObjectMeta: metav1.ObjectMeta{
-								Name:      image.CacheName(),
-								Namespace: image.Namespace,
-							},
-						},
 						image,
 						builder,
+						unresolvedSourceResolver(image),
 					},
 					WantErr: false,
-					WantDeletes: []clientgotesting.DeleteActionImpl{
-						{
-							Name: image.CacheName(),
-						},
-					},
 					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
 						{
 							Object: &v1alpha1.Image{
@@ -600,6 +1159,37 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				})
+
+				assert.Equal(t, 1, fakeRegistryAccessChecker.HasWriteAccessCallCount())
+			})
+
+			it("does not recheck write access verified for the current generation", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						unresolvedSourceResolver(image),
+					},
+					WantErr: false,
+				})
+
+				assert.Equal(t, 0, fakeRegistryAccessChecker.HasWriteAccessCallCount())
+			})
+
+			it("returns an error when write access cannot be verified", func() {
+				image.Status.Conditions = nil
+				fakeRegistryAccessChecker.HasWriteAccessReturns(false, errors.New("registry unavailable"))
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						resolvedSourceResolver(image),
+					},
+					WantErr: true,
+				})
 			})
 		})
 
@@ -644,6 +1234,10 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 												Reason:  v1alpha1.BuilderNotReady,
 												Message: "Builder builder-name is not ready",
 											},
+											{
+												Type:   v1alpha1.ConditionRegistryAccessReady,
+												Status: corev1.ConditionTrue,
+											},
 										},
 									},
 								},
@@ -712,6 +1306,46 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
+			it("does not schedule a build when a tag template cannot be rendered", func() {
+				image.Spec.ImageTaggingStrategy = v1alpha1.Template
+				image.Spec.TagTemplates = []string{"{{if .GitSHA}}{{slice .GitSHA 0 50}}{{end}}"}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						resolvedSourceResolver(image),
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.TagTemplateFailed,
+												Message: "Unable to render tag template {{if .GitSHA}}{{slice .GitSHA 0 50}}{{end}}: template: tag:1:16: executing \"tag\" at <slice .GitSHA 0 50>: error calling slice: index out of range: 50.",
+											},
+											{
+												Type:   v1alpha1.ConditionRegistryAccessReady,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
 			it("schedules a build with a desired build cache", func() {
 				cacheSize := resource.MustParse("2.5")
 				image.Spec.CacheSize = &cacheSize
@@ -775,6 +1409,10 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 												Type:   v1alpha1.ConditionBuilderReady,
 												Status: corev1.ConditionTrue,
 											},
+											{
+												Type:   v1alpha1.ConditionRegistryAccessReady,
+												Status: corev1.ConditionTrue,
+											},
 										},
 									},
 									LatestBuildRef: "image-name-build-1-00001", // GenerateNameReactor
@@ -1304,6 +1942,245 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					})
 				})
+
+				it("deletes all builds exceeding the limits in one pass", func() {
+					image.Spec.FailedBuildHistoryLimit = limit(2)
+					image.Status.LatestBuildRef = "image-name-build-5"
+					image.Status.Conditions = conditionNotReady()
+					image.Status.BuildCounter = 5
+					sourceResolver := resolvedSourceResolver(image)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: runtimeObjects(
+							failedBuilds(image, sourceResolver, 5),
+							image,
+							builder,
+							sourceResolver,
+						),
+						WantErr: false,
+						WantDeletes: []clientgotesting.DeleteActionImpl{
+							{Name: image.Name + "-build-1"},
+							{Name: image.Name + "-build-2"},
+							{Name: image.Name + "-build-3"},
+						},
+					})
+
+					assert.Equal(t, 0, fakeImageDeleter.DeleteCallCount())
+				})
+
+				when("images of old builds are deleted", func() {
+					it.Before(func() {
+						image.Spec.SuccessBuildHistoryLimit = limit(2)
+						image.Spec.Retention = &v1alpha1.ImageRetention{DeleteImages: true}
+						image.Status.LatestBuildRef = "image-name-build-4"
+						image.Status.LatestImage = "some/image@sha256:build-4"
+						image.Status.Conditions = conditionReady()
+						image.Status.BuildCounter = 4
+					})
+
+					it("deletes the images of the deleted builds that are not referenced by retained builds", func() {
+						sourceResolver := resolvedSourceResolver(image)
+						oldBuilds := successfulBuilds(image, sourceResolver, 4)
+						oldBuilds[1].(*v1alpha1.Build).Status.LatestImage = "some/image@sha256:build-3"
+						for i, build := range oldBuilds {
+							build.(*v1alpha1.Build).Status.PushedTags = []v1alpha1.PushedTag{
+								{Tag: "some/image"},
+								{Tag: fmt.Sprintf("some/image:b%d", i+1)},
+							}
+						}
+
+						rt.Test(rtesting.TableRow{
+							Key: key,
+							Objects: runtimeObjects(
+								oldBuilds,
+								image,
+								builder,
+								sourceResolver,
+							),
+							WantErr: false,
+							WantDeletes: []clientgotesting.DeleteActionImpl{
+								{Name: image.Name + "-build-1"},
+								{Name: image.Name + "-build-2"},
+							},
+						})
+
+						require.Equal(t, 1, fakeImageDeleter.DeleteCallCount())
+						images, removableTags, secretRef := fakeImageDeleter.DeleteArgsForCall(0)
+						assert.Equal(t, []string{"some/image@sha256:build-1"}, images)
+						assert.Equal(t, []string{"some/image:b1", "some/image:b2"}, removableTags)
+						assert.Equal(t, registry.SecretRef{
+							ServiceAccount: image.Spec.ServiceAccount,
+							Namespace:      image.Namespace,
+						}, secretRef)
+					})
+
+					it("deletes the exported images of labeled builds", func() {
+						sourceResolver := resolvedSourceResolver(image)
+						oldBuilds := successfulBuilds(image, sourceResolver, 4)
+						oldBuilds[1].(*v1alpha1.Build).Status.LatestImage = "some/image@sha256:build-3"
+						oldBuilds[0].(*v1alpha1.Build).Status.ExportedImage = "some/image@sha256:build-1-exported"
+
+						rt.Test(rtesting.TableRow{
+							Key: key,
+							Objects: runtimeObjects(
+								oldBuilds,
+								image,
+								builder,
+								sourceResolver,
+							),
+							WantErr: false,
+							WantDeletes: []clientgotesting.DeleteActionImpl{
+								{Name: image.Name + "-build-1"},
+								{Name: image.Name + "-build-2"},
+							},
+						})
+
+						require.Equal(t, 1, fakeImageDeleter.DeleteCallCount())
+						images, _, _ := fakeImageDeleter.DeleteArgsForCall(0)
+						assert.Equal(t, []string{
+							"some/image@sha256:build-1",
+							"some/image@sha256:build-1-exported",
+						}, images)
+					})
+
+					it("deletes the platform images of multi-platform builds", func() {
+						sourceResolver := resolvedSourceResolver(image)
+						oldBuilds := successfulBuilds(image, sourceResolver, 4)
+						oldBuilds[1].(*v1alpha1.Build).Status.LatestImage = "some/image@sha256:build-3"
+						oldBuilds[0].(*v1alpha1.Build).Status.Platforms = []v1alpha1.BuildPlatformStatus{
+							{Platform: "linux/amd64", LatestImage: "some/image@sha256:build-1-amd64", ExportedImage: "some/image@sha256:build-1-amd64-exported"},
+							{Platform: "linux/arm64", LatestImage: "some/image@sha256:build-1-arm64"},
+						}
+
+						rt.Test(rtesting.TableRow{
+							Key: key,
+							Objects: runtimeObjects(
+								oldBuilds,
+								image,
+								builder,
+								sourceResolver,
+							),
+							WantErr: false,
+							WantDeletes: []clientgotesting.DeleteActionImpl{
+								{Name: image.Name + "-build-1"},
+								{Name: image.Name + "-build-2"},
+							},
+						})
+
+						require.Equal(t, 1, fakeImageDeleter.DeleteCallCount())
+						images, _, _ := fakeImageDeleter.DeleteArgsForCall(0)
+						assert.Equal(t, []string{
+							"some/image@sha256:build-1",
+							"some/image@sha256:build-1-amd64",
+							"some/image@sha256:build-1-amd64-exported",
+							"some/image@sha256:build-1-arm64",
+						}, images)
+					})
+
+					it("keeps the images promoted by an image promotion", func() {
+						sourceResolver := resolvedSourceResolver(image)
+						oldBuilds := successfulBuilds(image, sourceResolver, 4)
+						oldBuilds[1].(*v1alpha1.Build).Status.LatestImage = "some/image@sha256:build-3"
+						promotion := &v1alpha1.ImagePromotion{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "some-promotion",
+								Namespace: namespace,
+							},
+							Spec: v1alpha1.ImagePromotionSpec{
+								Build: image.Name + "-build-1",
+								Tag:   "some/image:prod",
+							},
+							Status: v1alpha1.ImagePromotionStatus{
+								PromotedImage: "some/image@sha256:build-1",
+							},
+						}
+
+						rt.Test(rtesting.TableRow{
+							Key: key,
+							Objects: runtimeObjects(
+								oldBuilds,
+								image,
+								builder,
+								sourceResolver,
+								promotion,
+							),
+							WantErr: false,
+							WantDeletes: []clientgotesting.DeleteActionImpl{
+								{Name: image.Name + "-build-1"},
+								{Name: image.Name + "-build-2"},
+							},
+						})
+
+						assert.Equal(t, 0, fakeImageDeleter.DeleteCallCount())
+					})
+
+					it("keeps the builds when the images cannot be deleted", func() {
+						fakeImageDeleter.DeleteReturns(errors.New("unauthorized"))
+						sourceResolver := resolvedSourceResolver(image)
+
+						rt.Test(rtesting.TableRow{
+							Key: key,
+							Objects: runtimeObjects(
+								successfulBuilds(image, sourceResolver, 4),
+								image,
+								builder,
+								sourceResolver,
+							),
+							WantErr: true,
+						})
+					})
+				})
+			})
+
+			it("reports the failure of the last build on the image", func() {
+				sourceResolver := resolvedSourceResolver(image)
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: runtimeObjects(
+						builds(image, sourceResolver, 1, duckv1alpha1.Condition{
+							Type:    duckv1alpha1.ConditionSucceeded,
+							Status:  corev1.ConditionFalse,
+							Reason:  v1alpha1.BuildStepFailed,
+							Message: "step build failed with exit code 1: no buildpacks participating",
+						}),
+						image,
+						builder,
+						sourceResolver,
+					),
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.BuildStepFailed,
+												Message: "Build image-name-build-1 failed: step build failed with exit code 1: no buildpacks participating",
+											},
+											{
+												Type:   v1alpha1.ConditionBuilderReady,
+												Status: corev1.ConditionTrue,
+											},
+											{
+												Type:   v1alpha1.ConditionRegistryAccessReady,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									LatestBuildRef: "image-name-build-1",
+									BuildCounter:   1,
+								},
+							},
+						},
+					},
+				})
 			})
 
 			it("updates the last successful build on the image when the last build is successful", func() {
@@ -1338,6 +2215,10 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 												Type:   v1alpha1.ConditionBuilderReady,
 												Status: corev1.ConditionTrue,
 											},
+											{
+												Type:   v1alpha1.ConditionRegistryAccessReady,
+												Status: corev1.ConditionTrue,
+											},
 										},
 									},
 									LatestBuildRef: "image-name-build-1",
@@ -1452,6 +2333,10 @@ func conditionReadyUnknown() duckv1alpha1.Conditions {
 			Type:   v1alpha1.ConditionBuilderReady,
 			Status: corev1.ConditionTrue,
 		},
+		{
+			Type:   v1alpha1.ConditionRegistryAccessReady,
+			Status: corev1.ConditionTrue,
+		},
 	}
 }
 
@@ -1465,6 +2350,10 @@ func conditionReady() duckv1alpha1.Conditions {
 			Type:   v1alpha1.ConditionBuilderReady,
 			Status: corev1.ConditionTrue,
 		},
+		{
+			Type:   v1alpha1.ConditionRegistryAccessReady,
+			Status: corev1.ConditionTrue,
+		},
 	}
 }
 
@@ -1478,5 +2367,9 @@ func conditionNotReady() duckv1alpha1.Conditions {
 			Type:   v1alpha1.ConditionBuilderReady,
 			Status: corev1.ConditionTrue,
 		},
+		{
+			Type:   v1alpha1.ConditionRegistryAccessReady,
+			Status: corev1.ConditionTrue,
+		},
 	}
 }
@@ -0,0 +1,237 @@
+package imagepromotion
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/tracker"
+)
+
+const (
+	ReconcilerName = "ImagePromotions"
+	Kind           = "ImagePromotion"
+)
+
+//go:generate counterfeiter . ImagePromoter
+type ImagePromoter interface {
+	Promote(source, tag string, secretRef registry.SecretRef) (string, error)
+}
+
+type Tracker interface {
+	Track(ref metav1.ObjectMetaAccessor, obj types.NamespacedName) error
+	OnChanged(obj interface{})
+}
+
+func NewController(opt reconciler.Options, imagePromotionInformer v1alpha1informers.ImagePromotionInformer, imageInformer v1alpha1informers.ImageInformer, buildInformer v1alpha1informers.BuildInformer, imagePromoter ImagePromoter) *controller.Impl {
+	c := &Reconciler{
+		Client:               opt.Client,
+		ImagePromotionLister: imagePromotionInformer.Lister(),
+		ImageLister:          imageInformer.Lister(),
+		BuildLister:          buildInformer.Lister(),
+		ImagePromoter:        imagePromoter,
+	}
+
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+
+	imagePromotionInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	c.Tracker = tracker.New(impl.EnqueueKey, opt.TrackerResyncPeriod())
+
+	imageInformer.Informer().AddEventHandler(reconciler.Handler(controller.EnsureTypeMeta(
+		c.Tracker.OnChanged,
+		(&v1alpha1.Image{}).GetGroupVersionKind(),
+	)))
+
+	buildInformer.Informer().AddEventHandler(reconciler.Handler(controller.EnsureTypeMeta(
+		c.Tracker.OnChanged,
+		(&v1alpha1.Build{}).GetGroupVersionKind(),
+	)))
+
+	return impl
+}
+
+type Reconciler struct {
+	Client               versioned.Interface
+	ImagePromotionLister v1alpha1Listers.ImagePromotionLister
+	ImageLister          v1alpha1Listers.ImageLister
+	BuildLister          v1alpha1Listers.BuildLister
+	ImagePromoter        ImagePromoter
+	Tracker              Tracker
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, promotionName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	promotion, err := c.ImagePromotionLister.ImagePromotions(namespace).Get(promotionName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	promotion = promotion.DeepCopy()
+
+	promotion, err = c.reconcilePromotion(promotion)
+
+	updateErr := c.updateStatus(promotion)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	return err
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.ImagePromotion) error {
+	original, err := c.ImagePromotionLister.ImagePromotions(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.BuildV1alpha1().ImagePromotions(desired.Namespace).UpdateStatus(desired)
+	return err
+}
+
+func (c *Reconciler) reconcilePromotion(promotion *v1alpha1.ImagePromotion) (*v1alpha1.ImagePromotion, error) {
+	source, err := c.promotionSource(promotion)
+	if k8serrors.IsNotFound(err) {
+		promotion.Status.Conditions = condition(corev1.ConditionFalse, err.Error())
+		promotion.Status.ObservedGeneration = promotion.Generation
+		return promotion, nil
+	} else if err != nil {
+		return promotion, err
+	}
+
+	if source.image == "" {
+		promotion.Status.Conditions = condition(source.status, source.message)
+		promotion.Status.ObservedGeneration = promotion.Generation
+		return promotion, nil
+	}
+
+	if promotion.Status.SourceImage == source.image &&
+		promotion.Status.ObservedGeneration == promotion.Generation &&
+		promotion.Status.GetCondition(duckv1alpha1.ConditionReady).IsTrue() {
+		return promotion, nil
+	}
+
+	promoted, err := c.ImagePromoter.Promote(source.image, promotion.Spec.Tag, registry.SecretRef{
+		ServiceAccount: source.serviceAccount,
+		Namespace:      promotion.Namespace,
+	})
+	if err != nil {
+		promotion.Status.Conditions = condition(corev1.ConditionFalse, err.Error())
+		promotion.Status.ObservedGeneration = promotion.Generation
+		return promotion, err
+	}
+
+	promotion.Status = v1alpha1.ImagePromotionStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: promotion.Generation,
+			Conditions:         condition(corev1.ConditionTrue, ""),
+		},
+		SourceImage:   source.image,
+		PromotedImage: promoted,
+	}
+	return promotion, nil
+}
+
+type promotionSource struct {
+	image          string
+	serviceAccount string
+	status         corev1.ConditionStatus
+	message        string
+}
+
+// promotionSource resolves the image to promote. The image is empty while the
+// referenced Image or Build has not produced one.
+func (c *Reconciler) promotionSource(promotion *v1alpha1.ImagePromotion) (promotionSource, error) {
+	if promotion.Spec.Build != "" {
+		build, err := c.BuildLister.Builds(promotion.Namespace).Get(promotion.Spec.Build)
+		if err != nil {
+			return promotionSource{}, err
+		}
+
+		if err := c.Tracker.Track(build, promotion.NamespacedName()); err != nil {
+			return promotionSource{}, err
+		}
+
+		return sourceFromBuild(build, serviceAccountOrDefault(promotion.Spec.ServiceAccount, build.Spec.ServiceAccount)), nil
+	}
+
+	image, err := c.ImageLister.Images(promotion.Namespace).Get(promotion.Spec.Image)
+	if err != nil {
+		return promotionSource{}, err
+	}
+
+	if err := c.Tracker.Track(image, promotion.NamespacedName()); err != nil {
+		return promotionSource{}, err
+	}
+
+	if image.Status.LatestImage == "" {
+		return promotionSource{
+			status:  corev1.ConditionUnknown,
+			message: fmt.Sprintf("Image %s has not been built", image.Name),
+		}, nil
+	}
+
+	return promotionSource{
+		image:          image.Status.LatestImage,
+		serviceAccount: serviceAccountOrDefault(promotion.Spec.ServiceAccount, image.Spec.ServiceAccount),
+	}, nil
+}
+
+func sourceFromBuild(build *v1alpha1.Build, serviceAccount string) promotionSource {
+	switch {
+	case build.IsSuccess():
+		return promotionSource{image: build.Status.LatestImage, serviceAccount: serviceAccount}
+	case build.Finished():
+		return promotionSource{
+			status:  corev1.ConditionFalse,
+			message: fmt.Sprintf("Build %s failed", build.Name),
+		}
+	default:
+		return promotionSource{
+			status:  corev1.ConditionUnknown,
+			message: fmt.Sprintf("Build %s has not finished", build.Name),
+		}
+	}
+}
+
+func serviceAccountOrDefault(serviceAccount, fallback string) string {
+	if serviceAccount != "" {
+		return serviceAccount
+	}
+	return fallback
+}
+
+func condition(status corev1.ConditionStatus, message string) duckv1alpha1.Conditions {
+	return duckv1alpha1.Conditions{
+		{
+			Type:               duckv1alpha1.ConditionReady,
+			Status:             status,
+			Message:            message,
+			LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+		},
+	}
+}
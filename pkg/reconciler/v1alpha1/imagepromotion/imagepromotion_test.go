@@ -0,0 +1,372 @@
+package imagepromotion_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/imagepromotion"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/imagepromotion/imagepromotionfakes"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestImagePromotionReconciler(t *testing.T) {
+	spec.Run(t, "Image Promotion Reconciler", testImagePromotionReconciler)
+}
+
+func testImagePromotionReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		namespace               = "some-namespace"
+		promotionName           = "promotion-name"
+		key                     = "some-namespace/promotion-name"
+		targetTag               = "prod.registry.io/app:latest"
+		sourceImage             = "dev.registry.io/app@sha256:source-digest"
+		promotedImage           = "prod.registry.io/app@sha256:source-digest"
+		initialGeneration int64 = 1
+	)
+
+	var (
+		fakeImagePromoter = &imagepromotionfakes.FakeImagePromoter{}
+		fakeTracker       = fakeTracker{}
+	)
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList, reporter *rtesting.FakeStatsReporter) {
+			listers := testhelpers.NewListers(row.Objects)
+
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+
+			eventRecorder := record.NewFakeRecorder(10)
+			actionRecorderList := rtesting.ActionRecorderList{fakeClient}
+			eventList := rtesting.EventList{Recorder: eventRecorder}
+			r := &imagepromotion.Reconciler{
+				Client:               fakeClient,
+				ImagePromotionLister: listers.GetImagePromotionLister(),
+				ImageLister:          listers.GetImageLister(),
+				BuildLister:          listers.GetBuildLister(),
+				ImagePromoter:        fakeImagePromoter,
+				Tracker:              fakeTracker,
+			}
+
+			return r, actionRecorderList, eventList, &rtesting.FakeStatsReporter{}
+		})
+
+	image := &v1alpha1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "image-name",
+			Namespace: namespace,
+			UID:       "image-uid",
+		},
+		Spec: v1alpha1.ImageSpec{
+			Tag:            "dev.registry.io/app",
+			ServiceAccount: "image-service-account",
+		},
+		Status: v1alpha1.ImageStatus{
+			LatestImage: sourceImage,
+		},
+	}
+
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "build-name",
+			Namespace: namespace,
+			UID:       "build-uid",
+		},
+		Spec: v1alpha1.BuildSpec{
+			Tags:           []string{"dev.registry.io/app"},
+			ServiceAccount: "build-service-account",
+		},
+		Status: v1alpha1.BuildStatus{
+			Status: duckv1alpha1.Status{
+				Conditions: duckv1alpha1.Conditions{
+					{
+						Type:   duckv1alpha1.ConditionSucceeded,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+			LatestImage: sourceImage,
+		},
+	}
+
+	promotion := &v1alpha1.ImagePromotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       promotionName,
+			Namespace:  namespace,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.ImagePromotionSpec{
+			Image: image.Name,
+			Tag:   targetTag,
+		},
+	}
+
+	promotedStatus := v1alpha1.ImagePromotionStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: initialGeneration,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:   duckv1alpha1.ConditionReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+		SourceImage:   sourceImage,
+		PromotedImage: promotedImage,
+	}
+
+	statusWithCondition := func(status corev1.ConditionStatus, message string) v1alpha1.ImagePromotionStatus {
+		return v1alpha1.ImagePromotionStatus{
+			Status: duckv1alpha1.Status{
+				ObservedGeneration: initialGeneration,
+				Conditions: duckv1alpha1.Conditions{
+					{
+						Type:    duckv1alpha1.ConditionReady,
+						Status:  status,
+						Message: message,
+					},
+				},
+			},
+		}
+	}
+
+	when("#Reconcile", func() {
+		fakeImagePromoter.PromoteReturns(promotedImage, nil)
+
+		when("an image is promoted", func() {
+			it("copies the latest image of the image to the tag", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     promotedStatus,
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeImagePromoter.PromoteCallCount())
+				source, tag, secretRef := fakeImagePromoter.PromoteArgsForCall(0)
+				assert.Equal(t, sourceImage, source)
+				assert.Equal(t, targetTag, tag)
+				assert.Equal(t, registry.SecretRef{
+					ServiceAccount: "image-service-account",
+					Namespace:      namespace,
+				}, secretRef)
+
+				assert.True(t, fakeTracker.IsTracking(image, promotion.NamespacedName()))
+			})
+
+			it("uses the service account of the promotion when it is set", func() {
+				promotion.Spec.ServiceAccount = "promotion-service-account"
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     promotedStatus,
+							},
+						},
+					},
+				})
+
+				_, _, secretRef := fakeImagePromoter.PromoteArgsForCall(0)
+				assert.Equal(t, "promotion-service-account", secretRef.ServiceAccount)
+			})
+
+			it("does not promote the same image again", func() {
+				promotion.Status = promotedStatus
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: false,
+				})
+
+				assert.Equal(t, 0, fakeImagePromoter.PromoteCallCount())
+			})
+
+			it("promotes a new latest image of the image", func() {
+				promotion.Status = promotedStatus
+				image.Status.LatestImage = "dev.registry.io/app@sha256:new-digest"
+				fakeImagePromoter.PromoteReturns("prod.registry.io/app@sha256:new-digest", nil)
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status: v1alpha1.ImagePromotionStatus{
+									Status:        promotedStatus.Status,
+									SourceImage:   "dev.registry.io/app@sha256:new-digest",
+									PromotedImage: "prod.registry.io/app@sha256:new-digest",
+								},
+							},
+						},
+					},
+				})
+
+				source, _, _ := fakeImagePromoter.PromoteArgsForCall(0)
+				assert.Equal(t, "dev.registry.io/app@sha256:new-digest", source)
+			})
+
+			it("waits for the image to be built", func() {
+				image.Status.LatestImage = ""
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     statusWithCondition(corev1.ConditionUnknown, "Image image-name has not been built"),
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeImagePromoter.PromoteCallCount())
+			})
+
+			it("reports a missing image", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     statusWithCondition(corev1.ConditionFalse, `image.build.pivotal.io "image-name" not found`),
+							},
+						},
+					},
+				})
+			})
+
+			it("reports promotion failures", func() {
+				fakeImagePromoter.PromoteReturns("", errors.New("unauthorized"))
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{image, promotion},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     statusWithCondition(corev1.ConditionFalse, "unauthorized"),
+							},
+						},
+					},
+				})
+			})
+		})
+
+		when("a build is promoted", func() {
+			it.Before(func() {
+				promotion.Spec.Image = ""
+				promotion.Spec.Build = build.Name
+			})
+
+			it("copies the image of the build to the tag", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{build, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     promotedStatus,
+							},
+						},
+					},
+				})
+
+				source, tag, secretRef := fakeImagePromoter.PromoteArgsForCall(0)
+				assert.Equal(t, sourceImage, source)
+				assert.Equal(t, targetTag, tag)
+				assert.Equal(t, "build-service-account", secretRef.ServiceAccount)
+
+				assert.True(t, fakeTracker.IsTracking(build, promotion.NamespacedName()))
+			})
+
+			it("waits for the build to finish", func() {
+				build.Status.Conditions[0].Status = corev1.ConditionUnknown
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{build, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     statusWithCondition(corev1.ConditionUnknown, "Build build-name has not finished"),
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeImagePromoter.PromoteCallCount())
+			})
+
+			it("does not promote failed builds", func() {
+				build.Status.Conditions[0].Status = corev1.ConditionFalse
+
+				rt.Test(rtesting.TableRow{
+					Key:     key,
+					Objects: []runtime.Object{build, promotion},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.ImagePromotion{
+								ObjectMeta: promotion.ObjectMeta,
+								Spec:       promotion.Spec,
+								Status:     statusWithCondition(corev1.ConditionFalse, "Build build-name failed"),
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 0, fakeImagePromoter.PromoteCallCount())
+			})
+		})
+	})
+}
@@ -0,0 +1,17 @@
+package stack
+
+import (
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type workQueueEnqueuer struct {
+	enqueueAfter func(obj interface{}, after time.Duration)
+	delay        time.Duration
+}
+
+func (e *workQueueEnqueuer) Enqueue(stack *v1alpha1.Stack) error {
+	e.enqueueAfter(stack, e.delay)
+	return nil
+}
@@ -0,0 +1,142 @@
+package stack
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler"
+)
+
+const (
+	ReconcilerName = "Stacks"
+	Kind           = "Stack"
+)
+
+//go:generate counterfeiter . StackReader
+type StackReader interface {
+	Read(stack *v1alpha1.Stack) (v1alpha1.ResolvedStack, error)
+}
+
+func NewController(opt reconciler.Options, stackInformer v1alpha1informers.StackInformer, stackReader StackReader) *controller.Impl {
+	c := &Reconciler{
+		Client:      opt.Client,
+		StackReader: stackReader,
+		StackLister: stackInformer.Lister(),
+	}
+
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+
+	c.Enqueuer = &workQueueEnqueuer{
+		enqueueAfter: impl.EnqueueAfter,
+		delay:        opt.BuilderPollingFrequency,
+	}
+
+	stackInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	return impl
+}
+
+//go:generate counterfeiter . Enqueuer
+type Enqueuer interface {
+	Enqueue(stack *v1alpha1.Stack) error
+}
+
+type Reconciler struct {
+	Client      versioned.Interface
+	StackReader StackReader
+	Enqueuer    Enqueuer
+	StackLister v1alpha1Listers.StackLister
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	_, stackName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	stack, err := c.StackLister.Get(stackName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	stack = stack.DeepCopy()
+
+	stack, err = c.reconcileStackStatus(stack)
+
+	updateErr := c.updateStatus(stack)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	// stack images are tags, poll them so that new digests are picked up
+	if err := c.Enqueuer.Enqueue(stack); err != nil {
+		return err
+	}
+
+	if err != nil {
+		return controller.NewPermanentError(err)
+	}
+	return nil
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.Stack) error {
+	original, err := c.StackLister.Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.BuildV1alpha1().Stacks().UpdateStatus(desired)
+	return err
+}
+
+func (c *Reconciler) reconcileStackStatus(stack *v1alpha1.Stack) (*v1alpha1.Stack, error) {
+	resolvedStack, err := c.StackReader.Read(stack)
+	if err != nil {
+		stack.Status = v1alpha1.StackStatus{
+			Status: duckv1alpha1.Status{
+				ObservedGeneration: stack.Generation,
+				Conditions: duckv1alpha1.Conditions{
+					{
+						Type:               duckv1alpha1.ConditionReady,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+						Message:            err.Error(),
+					},
+				},
+			},
+		}
+		return stack, err
+	}
+
+	stack.Status = v1alpha1.StackStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: stack.Generation,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+				},
+			},
+		},
+		ResolvedStack: resolvedStack,
+	}
+	return stack, nil
+}
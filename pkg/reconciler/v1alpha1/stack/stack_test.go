@@ -0,0 +1,194 @@
+package stack_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/stack"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/stack/stackfakes"
+)
+
+func TestStackReconciler(t *testing.T) {
+	spec.Run(t, "Stack Reconciler", testStackReconciler)
+}
+
+func testStackReconciler(t *testing.T, when spec.G, it spec.S) {
+	fakeStackReader := &stackfakes.FakeStackReader{}
+	fakeEnqueuer := &stackfakes.FakeEnqueuer{}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList, reporter *rtesting.FakeStatsReporter) {
+			listers := testhelpers.NewListers(row.Objects)
+
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+
+			eventRecorder := record.NewFakeRecorder(10)
+			actionRecorderList := rtesting.ActionRecorderList{fakeClient}
+			eventList := rtesting.EventList{Recorder: eventRecorder}
+			r := &stack.Reconciler{
+				Client:      fakeClient,
+				StackLister: listers.GetStackLister(),
+				StackReader: fakeStackReader,
+				Enqueuer:    fakeEnqueuer,
+			}
+
+			return r, actionRecorderList, eventList, &rtesting.FakeStatsReporter{}
+		})
+
+	const (
+		stackName               = "some-stack"
+		stackKey                = stackName
+		initialGeneration int64 = 1
+	)
+
+	testStack := &v1alpha1.Stack{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       stackName,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.StackSpec{
+			ID:         "io.buildpacks.stacks.bionic",
+			BuildImage: v1alpha1.StackSpecImage{Image: "some/build"},
+			RunImage:   v1alpha1.StackSpecImage{Image: "some/run"},
+			Mixins:     []string{"some-mixin"},
+		},
+	}
+
+	resolvedStack := v1alpha1.ResolvedStack{
+		ID: "io.buildpacks.stacks.bionic",
+		BuildImage: v1alpha1.StackStatusImage{
+			LatestImage: "some/build@sha256:build-digest",
+			Image:       "some/build",
+		},
+		RunImage: v1alpha1.StackStatusImage{
+			LatestImage: "some/run@sha256:run-digest",
+			Image:       "some/run",
+		},
+		Mixins: []string{"some-mixin"},
+	}
+
+	readyStatus := v1alpha1.StackStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: initialGeneration,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:   duckv1alpha1.ConditionReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+		ResolvedStack: resolvedStack,
+	}
+
+	when("#Reconcile", func() {
+		when("the stack can be resolved", func() {
+			fakeStackReader.ReadReturns(resolvedStack, nil)
+
+			it("saves the resolved stack to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     stackKey,
+					Objects: []runtime.Object{testStack},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Stack{
+								ObjectMeta: testStack.ObjectMeta,
+								Spec:       testStack.Spec,
+								Status:     readyStatus,
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeStackReader.ReadCallCount())
+				assert.Equal(t, testStack.Spec, fakeStackReader.ReadArgsForCall(0).Spec)
+			})
+
+			it("schedules the next poll", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     stackKey,
+					Objects: []runtime.Object{testStack},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Stack{
+								ObjectMeta: testStack.ObjectMeta,
+								Spec:       testStack.Spec,
+								Status:     readyStatus,
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("does not update the status with no status change", func() {
+				resolved := testStack.DeepCopy()
+				resolved.Status = readyStatus
+
+				rt.Test(rtesting.TableRow{
+					Key:     stackKey,
+					Objects: []runtime.Object{resolved},
+					WantErr: false,
+				})
+			})
+		})
+
+		when("the stack cannot be resolved", func() {
+			fakeStackReader.ReadReturns(v1alpha1.ResolvedStack{}, errors.New("mixin some-mixin is not present in the run image"))
+
+			it("saves not ready to the status and keeps polling", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     stackKey,
+					Objects: []runtime.Object{testStack},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Stack{
+								ObjectMeta: testStack.ObjectMeta,
+								Spec:       testStack.Spec,
+								Status: v1alpha1.StackStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: initialGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Message: "mixin some-mixin is not present in the run image",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+
+				assert.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+		})
+
+		it("does not return error on nonexistent stack", func() {
+			rt.Test(rtesting.TableRow{
+				Key:     stackKey,
+				WantErr: false,
+			})
+		})
+	})
+}
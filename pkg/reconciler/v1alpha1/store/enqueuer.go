@@ -0,0 +1,17 @@
+package store
+
+import (
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type workQueueEnqueuer struct {
+	enqueueAfter func(obj interface{}, after time.Duration)
+	delay        time.Duration
+}
+
+func (e *workQueueEnqueuer) Enqueue(store *v1alpha1.Store) error {
+	e.enqueueAfter(store, e.delay)
+	return nil
+}
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestEnqueueAfter(t *testing.T) {
+	store := &v1alpha1.Store{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "name",
+		},
+	}
+
+	enqueuer := &workQueueEnqueuer{
+		delay: time.Minute,
+		enqueueAfter: func(obj interface{}, after time.Duration) {
+			require.Equal(t, store, obj)
+			require.Equal(t, after, time.Minute)
+		},
+	}
+
+	err := enqueuer.Enqueue(store)
+	require.NoError(t, err)
+}
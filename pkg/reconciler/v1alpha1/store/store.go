@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler"
+)
+
+const (
+	ReconcilerName = "Stores"
+	Kind           = "Store"
+)
+
+//go:generate counterfeiter . StoreReader
+type StoreReader interface {
+	Read(store *v1alpha1.Store) ([]v1alpha1.StoreBuildpack, error)
+}
+
+func NewController(opt reconciler.Options, storeInformer v1alpha1informers.StoreInformer, storeReader StoreReader) *controller.Impl {
+	c := &Reconciler{
+		Client:      opt.Client,
+		StoreReader: storeReader,
+		StoreLister: storeInformer.Lister(),
+	}
+
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+
+	c.Enqueuer = &workQueueEnqueuer{
+		enqueueAfter: impl.EnqueueAfter,
+		delay:        opt.BuilderPollingFrequency,
+	}
+
+	storeInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	return impl
+}
+
+//go:generate counterfeiter . Enqueuer
+type Enqueuer interface {
+	Enqueue(store *v1alpha1.Store) error
+}
+
+type Reconciler struct {
+	Client      versioned.Interface
+	StoreReader StoreReader
+	Enqueuer    Enqueuer
+	StoreLister v1alpha1Listers.StoreLister
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	_, storeName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	store, err := c.StoreLister.Get(storeName)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	store = store.DeepCopy()
+
+	store, err = c.reconcileStoreStatus(store)
+
+	updateErr := c.updateStatus(store)
+	if updateErr != nil {
+		return updateErr
+	}
+
+	// store sources are tags, poll them so that new buildpacks are picked up
+	if err := c.Enqueuer.Enqueue(store); err != nil {
+		return err
+	}
+
+	if err != nil {
+		return controller.NewPermanentError(err)
+	}
+	return nil
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.Store) error {
+	original, err := c.StoreLister.Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.BuildV1alpha1().Stores().UpdateStatus(desired)
+	return err
+}
+
+func (c *Reconciler) reconcileStoreStatus(store *v1alpha1.Store) (*v1alpha1.Store, error) {
+	buildpacks, err := c.StoreReader.Read(store)
+	if err != nil {
+		store.Status = v1alpha1.StoreStatus{
+			Status: duckv1alpha1.Status{
+				ObservedGeneration: store.Generation,
+				Conditions: duckv1alpha1.Conditions{
+					{
+						Type:               duckv1alpha1.ConditionReady,
+						Status:             corev1.ConditionFalse,
+						LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+						Message:            err.Error(),
+					},
+				},
+			},
+		}
+		return store, err
+	}
+
+	store.Status = v1alpha1.StoreStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: store.Generation,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:               duckv1alpha1.ConditionReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: apis.VolatileTime{Inner: metav1.Now()},
+				},
+			},
+		},
+		Buildpacks: buildpacks,
+	}
+	return store, nil
+}
@@ -0,0 +1,171 @@
+package store_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	duckv1alpha1 "knative.dev/pkg/apis/duck/v1alpha1"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/store"
+	"github.com/pivotal/kpack/pkg/reconciler/v1alpha1/store/storefakes"
+)
+
+func TestStoreReconciler(t *testing.T) {
+	spec.Run(t, "Store Reconciler", testStoreReconciler)
+}
+
+func testStoreReconciler(t *testing.T, when spec.G, it spec.S) {
+	fakeStoreReader := &storefakes.FakeStoreReader{}
+	fakeEnqueuer := &storefakes.FakeEnqueuer{}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList, reporter *rtesting.FakeStatsReporter) {
+			listers := testhelpers.NewListers(row.Objects)
+
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+
+			eventRecorder := record.NewFakeRecorder(10)
+			actionRecorderList := rtesting.ActionRecorderList{fakeClient}
+			eventList := rtesting.EventList{Recorder: eventRecorder}
+			r := &store.Reconciler{
+				Client:      fakeClient,
+				StoreLister: listers.GetStoreLister(),
+				StoreReader: fakeStoreReader,
+				Enqueuer:    fakeEnqueuer,
+			}
+
+			return r, actionRecorderList, eventList, &rtesting.FakeStatsReporter{}
+		})
+
+	const (
+		storeName               = "some-store"
+		storeKey                = storeName
+		initialGeneration int64 = 1
+	)
+
+	testStore := &v1alpha1.Store{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       storeName,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.StoreSpec{
+			Sources: []v1alpha1.StoreImage{
+				{Image: "some/buildpackage"},
+			},
+		},
+	}
+
+	buildpacks := []v1alpha1.StoreBuildpack{
+		{
+			ID:         "org.some.buildpack",
+			Version:    "1.0.0",
+			StoreImage: v1alpha1.StoreImage{Image: "some/buildpackage@sha256:some-digest"},
+			DiffID:     "sha256:layer-diff-id",
+			API:        "0.2",
+			Stacks:     []v1alpha1.BuildpackStack{{ID: "io.buildpacks.stacks.bionic"}},
+		},
+	}
+
+	readyStatus := v1alpha1.StoreStatus{
+		Status: duckv1alpha1.Status{
+			ObservedGeneration: initialGeneration,
+			Conditions: duckv1alpha1.Conditions{
+				{
+					Type:   duckv1alpha1.ConditionReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+		Buildpacks: buildpacks,
+	}
+
+	when("#Reconcile", func() {
+		when("the store can be read", func() {
+			fakeStoreReader.ReadReturns(buildpacks, nil)
+
+			it("saves the available buildpacks to the status and schedules the next poll", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     storeKey,
+					Objects: []runtime.Object{testStore},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Store{
+								ObjectMeta: testStore.ObjectMeta,
+								Spec:       testStore.Spec,
+								Status:     readyStatus,
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 1, fakeStoreReader.ReadCallCount())
+				assert.Equal(t, testStore.Spec, fakeStoreReader.ReadArgsForCall(0).Spec)
+				assert.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+			})
+
+			it("does not update the status with no status change", func() {
+				read := testStore.DeepCopy()
+				read.Status = readyStatus
+
+				rt.Test(rtesting.TableRow{
+					Key:     storeKey,
+					Objects: []runtime.Object{read},
+					WantErr: false,
+				})
+			})
+		})
+
+		when("the store cannot be read", func() {
+			fakeStoreReader.ReadReturns(nil, errors.New("store image some/buildpackage is missing label io.buildpacks.buildpack.layers"))
+
+			it("saves not ready to the status", func() {
+				rt.Test(rtesting.TableRow{
+					Key:     storeKey,
+					Objects: []runtime.Object{testStore},
+					WantErr: true,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Store{
+								ObjectMeta: testStore.ObjectMeta,
+								Spec:       testStore.Spec,
+								Status: v1alpha1.StoreStatus{
+									Status: duckv1alpha1.Status{
+										ObservedGeneration: initialGeneration,
+										Conditions: duckv1alpha1.Conditions{
+											{
+												Type:    duckv1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Message: "store image some/buildpackage is missing label io.buildpacks.buildpack.layers",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+		})
+
+		it("does not return error on nonexistent store", func() {
+			rt.Test(rtesting.TableRow{
+				Key:     storeKey,
+				WantErr: false,
+			})
+		})
+	})
+}
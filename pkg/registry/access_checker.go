@@ -0,0 +1,21 @@
+package registry
+
+import (
+	"github.com/pivotal/kpack/pkg/dockercreds"
+)
+
+// AccessChecker verifies registry credentials before a build is scheduled.
+type AccessChecker struct {
+	KeychainFactory KeychainFactory
+}
+
+// HasWriteAccess reports whether the credentials of the secret ref can push to
+// the repository of the tag.
+func (a *AccessChecker) HasWriteAccess(tag string, secretRef SecretRef) (bool, error) {
+	keychain, err := a.KeychainFactory.KeychainForSecretRef(secretRef)
+	if err != nil {
+		return false, err
+	}
+
+	return dockercreds.HasWriteAccess(keychain, tag)
+}
@@ -0,0 +1,62 @@
+package registry_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestAccessChecker(t *testing.T) {
+	spec.Run(t, "Access Checker", testAccessChecker)
+}
+
+func testAccessChecker(t *testing.T, when spec.G, it spec.S) {
+	var (
+		uploadStatus    = http.StatusAccepted
+		server          *httptest.Server
+		host            string
+		keychainFactory *fakeKeychainFactory
+		subject         *registry.AccessChecker
+		secretRef       = registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}
+	)
+
+	it.Before(func() {
+		handler := http.NewServeMux()
+		handler.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		handler.HandleFunc("/v2/some/image/blobs/uploads/", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(uploadStatus)
+		})
+		server = httptest.NewServer(handler)
+		host = strings.TrimPrefix(server.URL, "http://")
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &registry.AccessChecker{KeychainFactory: keychainFactory}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("checks write access with the credentials of the secret ref", func() {
+		hasAccess, err := subject.HasWriteAccess(host+"/some/image:tag", secretRef)
+		require.NoError(t, err)
+		assert.True(t, hasAccess)
+		assert.Equal(t, secretRef, keychainFactory.secretRef)
+	})
+
+	it("reports denied access", func() {
+		uploadStatus = http.StatusForbidden
+
+		hasAccess, err := subject.HasWriteAccess(host+"/some/image:tag", secretRef)
+		require.NoError(t, err)
+		assert.False(t, hasAccess)
+	})
+}
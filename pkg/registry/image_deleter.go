@@ -0,0 +1,124 @@
+package registry
+
+import (
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+)
+
+// ImageDeleter removes images from the registry by digest. Deleting a
+// manifest also removes every tag that points to it.
+type ImageDeleter struct {
+	KeychainFactory KeychainFactory
+}
+
+// Delete deletes the images that are not referenced by a tag of their
+// repository other than the removable tags. Images that no longer exist are
+// ignored.
+func (d *ImageDeleter) Delete(images []string, removableTags []string, secretRef SecretRef) error {
+	keychain, err := d.KeychainFactory.KeychainForSecretRef(secretRef)
+	if err != nil {
+		return err
+	}
+
+	removable := map[string]bool{}
+	for _, tag := range removableTags {
+		ref, err := name.NewTag(tag, name.WeakValidation)
+		if err != nil {
+			return err
+		}
+		removable[ref.Name()] = true
+	}
+
+	referenced := map[string]map[string]bool{}
+	for _, image := range images {
+		ref, err := name.NewDigest(image, name.WeakValidation)
+		if err != nil {
+			return err
+		}
+
+		repository := ref.Context().Name()
+		if _, ok := referenced[repository]; !ok {
+			referenced[repository], err = referencedDigests(ref.Context(), removable, keychain)
+			if err != nil {
+				return err
+			}
+		}
+
+		if referenced[repository][ref.DigestStr()] {
+			continue
+		}
+
+		_, err = remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "unable to resolve %s", image)
+		}
+
+		if err := remote.Delete(ref, remote.WithAuthFromKeychain(keychain)); err != nil {
+			return errors.Wrapf(err, "unable to delete %s", image)
+		}
+	}
+	return nil
+}
+
+// referencedDigests returns the digests the tags of the repository resolve to,
+// including the images of image indexes, except for the removable tags.
+func referencedDigests(repository name.Repository, removable map[string]bool, keychain authn.Keychain) (map[string]bool, error) {
+	tags, err := remote.List(repository, remote.WithAuthFromKeychain(keychain))
+	if isNotFound(err) {
+		return map[string]bool{}, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "unable to list tags of %s", repository.Name())
+	}
+
+	digests := map[string]bool{}
+	for _, tag := range tags {
+		ref, err := name.NewTag(repository.Name()+":"+tag, name.WeakValidation)
+		if err != nil {
+			return nil, err
+		}
+
+		if removable[ref.Name()] {
+			continue
+		}
+
+		descriptor, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+		if isNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve %s", ref.Name())
+		}
+		digests[descriptor.Digest.String()] = true
+
+		if descriptor.MediaType != types.OCIImageIndex && descriptor.MediaType != types.DockerManifestList {
+			continue
+		}
+
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return nil, err
+		}
+
+		manifest, err := index.IndexManifest()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, child := range manifest.Manifests {
+			digests[child.Digest.String()] = true
+		}
+	}
+	return digests, nil
+}
+
+func isNotFound(err error) bool {
+	transportErr, ok := err.(*transport.Error)
+	return ok && transportErr.StatusCode == http.StatusNotFound
+}
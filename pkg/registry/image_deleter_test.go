@@ -0,0 +1,133 @@
+package registry_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestImageDeleter(t *testing.T) {
+	spec.Run(t, "Image Deleter", testImageDeleter)
+}
+
+func testImageDeleter(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server          *httptest.Server
+		host            string
+		tags            []string
+		deleted         []string
+		keychainFactory *fakeKeychainFactory
+		subject         *registry.ImageDeleter
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		tags = nil
+		deleted = nil
+		handler := ggcrregistry.New()
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/v2/app/tags/list":
+				require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"name": "app", "tags": tags}))
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusAccepted)
+			default:
+				handler.ServeHTTP(w, r)
+			}
+		}))
+		host = strings.TrimPrefix(server.URL, "http://")
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &registry.ImageDeleter{KeychainFactory: keychainFactory}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	pushImage := func(image v1.Image, tag string) v1.Hash {
+		ref, err := name.NewTag(host+"/app:"+tag, name.WeakValidation)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, image, remote.WithAuthFromKeychain(authn.DefaultKeychain)))
+		tags = append(tags, tag)
+		digest, err := image.Digest()
+		require.NoError(t, err)
+		return digest
+	}
+
+	pushRandomImage := func(tag string) v1.Hash {
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+		return pushImage(image, tag)
+	}
+
+	it("deletes the images by digest with the credentials of the secret ref", func() {
+		pushRandomImage("latest")
+		oldDigest := pushRandomImage("b1.20191021.100000")
+
+		secretRef := registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}
+		err := subject.Delete([]string{host + "/app@" + oldDigest.String()}, []string{host + "/app:b1.20191021.100000"}, secretRef)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"/v2/app/manifests/" + oldDigest.String()}, deleted)
+		assert.Equal(t, secretRef, keychainFactory.secretRef)
+	})
+
+	it("keeps images a tag other than the removable tags resolves to", func() {
+		currentDigest := pushRandomImage("latest")
+		oldDigest := pushRandomImage("b1.20191021.100000")
+
+		err := subject.Delete([]string{
+			host + "/app@" + oldDigest.String(),
+			host + "/app@" + currentDigest.String(),
+		}, []string{host + "/app:b1.20191021.100000"}, registry.SecretRef{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"/v2/app/manifests/" + oldDigest.String()}, deleted)
+	})
+
+	it("keeps images that share their digest with a foreign tag", func() {
+		pushRandomImage("latest")
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+		oldDigest := pushImage(image, "b1.20191021.100000")
+		pushImage(image, "prod")
+
+		err = subject.Delete([]string{host + "/app@" + oldDigest.String()}, []string{host + "/app:b1.20191021.100000"}, registry.SecretRef{})
+		require.NoError(t, err)
+
+		assert.Empty(t, deleted)
+	})
+
+	it("deletes images when the image tag does not exist", func() {
+		oldDigest := pushRandomImage("b1.20191021.100000")
+
+		err := subject.Delete([]string{host + "/app@" + oldDigest.String()}, []string{host + "/app:b1.20191021.100000"}, registry.SecretRef{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"/v2/app/manifests/" + oldDigest.String()}, deleted)
+	})
+
+	it("ignores images that no longer exist", func() {
+		err := subject.Delete([]string{host + "/app@sha256:0000000000000000000000000000000000000000000000000000000000000000"}, nil, registry.SecretRef{})
+		require.NoError(t, err)
+
+		assert.Empty(t, deleted)
+	})
+}
@@ -0,0 +1,134 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// PlatformImage is the image built for a platform of the form os/arch[/variant].
+type PlatformImage struct {
+	Platform string
+	Image    string
+}
+
+// IndexWriter pushes an OCI image index of images built for different
+// platforms.
+type IndexWriter struct {
+	KeychainFactory KeychainFactory
+}
+
+// Write pushes the image index to every tag and returns the digest reference
+// of the index in the repository of the first tag. Images missing from the
+// repository of a tag are copied along with the index.
+func (w *IndexWriter) Write(tags []string, images []PlatformImage, secretRef SecretRef) (string, error) {
+	keychain, err := w.KeychainFactory.KeychainForSecretRef(secretRef)
+	if err != nil {
+		return "", err
+	}
+
+	index := &imageIndex{
+		manifest: &v1.IndexManifest{
+			SchemaVersion: 2,
+			MediaType:     types.OCIImageIndex,
+		},
+		images: map[v1.Hash]v1.Image{},
+	}
+
+	for _, platformImage := range images {
+		os, arch, variant, err := v1alpha1.ParsePlatform(platformImage.Platform)
+		if err != nil {
+			return "", err
+		}
+
+		ref, err := name.NewDigest(platformImage.Image, name.WeakValidation)
+		if err != nil {
+			return "", err
+		}
+
+		descriptor, err := remote.Get(ref, remote.WithAuthFromKeychain(keychain))
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to resolve %s", platformImage.Image)
+		}
+
+		image, err := descriptor.Image()
+		if err != nil {
+			return "", err
+		}
+
+		index.manifest.Manifests = append(index.manifest.Manifests, v1.Descriptor{
+			MediaType: descriptor.MediaType,
+			Size:      descriptor.Size,
+			Digest:    descriptor.Digest,
+			Platform: &v1.Platform{
+				OS:           os,
+				Architecture: arch,
+				Variant:      variant,
+			},
+		})
+		index.images[descriptor.Digest] = image
+	}
+
+	digest, err := index.Digest()
+	if err != nil {
+		return "", err
+	}
+
+	var identifier string
+	for _, tag := range tags {
+		ref, err := name.NewTag(tag, name.WeakValidation)
+		if err != nil {
+			return "", err
+		}
+
+		if err := remote.WriteIndex(ref, index, remote.WithAuthFromKeychain(keychain)); err != nil {
+			return "", errors.Wrapf(err, "unable to push image index to %s", tag)
+		}
+
+		if identifier == "" {
+			identifier = fmt.Sprintf("%s@%s", ref.Context().Name(), digest)
+		}
+	}
+	return identifier, nil
+}
+
+type imageIndex struct {
+	manifest *v1.IndexManifest
+	images   map[v1.Hash]v1.Image
+}
+
+func (i *imageIndex) MediaType() (types.MediaType, error) {
+	return i.manifest.MediaType, nil
+}
+
+func (i *imageIndex) Digest() (v1.Hash, error) {
+	return partial.Digest(i)
+}
+
+func (i *imageIndex) IndexManifest() (*v1.IndexManifest, error) {
+	return i.manifest, nil
+}
+
+func (i *imageIndex) RawManifest() ([]byte, error) {
+	return json.Marshal(i.manifest)
+}
+
+func (i *imageIndex) Image(h v1.Hash) (v1.Image, error) {
+	image, ok := i.images[h]
+	if !ok {
+		return nil, errors.Errorf("image %s is not part of the index", h)
+	}
+	return image, nil
+}
+
+func (i *imageIndex) ImageIndex(h v1.Hash) (v1.ImageIndex, error) {
+	return nil, errors.Errorf("index %s is not part of the index", h)
+}
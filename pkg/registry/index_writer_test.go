@@ -0,0 +1,110 @@
+package registry_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestIndexWriter(t *testing.T) {
+	spec.Run(t, "Index Writer", testIndexWriter)
+}
+
+func testIndexWriter(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server          *httptest.Server
+		host            string
+		keychainFactory *fakeKeychainFactory
+		subject         *registry.IndexWriter
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewServer(ggcrregistry.New())
+		host = strings.TrimPrefix(server.URL, "http://")
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &registry.IndexWriter{KeychainFactory: keychainFactory}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("pushes an image index of the platform images to every tag", func() {
+		amd64Digest := pushPlatformImage(t, host+"/app:latest-linux-amd64", "linux", "amd64")
+		arm64Digest := pushPlatformImage(t, host+"/app:latest-linux-arm64", "linux", "arm64")
+
+		secretRef := registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}
+		identifier, err := subject.Write([]string{host + "/app:latest", host + "/other:b1"}, []registry.PlatformImage{
+			{Platform: "linux/amd64", Image: host + "/app@" + amd64Digest.String()},
+			{Platform: "linux/arm64", Image: host + "/app@" + arm64Digest.String()},
+		}, secretRef)
+		require.NoError(t, err)
+		assert.Equal(t, secretRef, keychainFactory.secretRef)
+
+		for _, tag := range []string{host + "/app:latest", host + "/other:b1"} {
+			ref, err := name.ParseReference(tag, name.WeakValidation)
+			require.NoError(t, err)
+			index, err := remote.Index(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+			require.NoError(t, err)
+
+			digest, err := index.Digest()
+			require.NoError(t, err)
+			assert.Equal(t, host+"/app@"+digest.String(), identifier)
+
+			manifest, err := index.IndexManifest()
+			require.NoError(t, err)
+			assert.Equal(t, types.OCIImageIndex, manifest.MediaType)
+			require.Len(t, manifest.Manifests, 2)
+			assert.Equal(t, amd64Digest, manifest.Manifests[0].Digest)
+			assert.Equal(t, &v1.Platform{OS: "linux", Architecture: "amd64"}, manifest.Manifests[0].Platform)
+			assert.Equal(t, arm64Digest, manifest.Manifests[1].Digest)
+			assert.Equal(t, &v1.Platform{OS: "linux", Architecture: "arm64"}, manifest.Manifests[1].Platform)
+
+			_, err = remote.Image(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain), remote.WithPlatform(v1.Platform{OS: "linux", Architecture: "arm64"}))
+			require.NoError(t, err)
+		}
+	})
+
+	it("returns an error when a platform image does not exist", func() {
+		_, err := subject.Write([]string{host + "/app:latest"}, []registry.PlatformImage{
+			{Platform: "linux/amd64", Image: host + "/app@sha256:1111111111111111111111111111111111111111111111111111111111111111"},
+		}, registry.SecretRef{})
+		require.Error(t, err)
+	})
+}
+
+func pushPlatformImage(t *testing.T, tag, os, arch string) v1.Hash {
+	image, err := random.Image(10, 1)
+	require.NoError(t, err)
+	config, err := image.ConfigFile()
+	require.NoError(t, err)
+	config.OS = os
+	config.Architecture = arch
+	image, err = mutate.ConfigFile(image, config)
+	require.NoError(t, err)
+
+	ref, err := name.ParseReference(tag, name.WeakValidation)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, image, remote.WithAuthFromKeychain(authn.DefaultKeychain)))
+
+	digest, err := image.Digest()
+	require.NoError(t, err)
+	return digest
+}
@@ -0,0 +1,62 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// PlatformResolver resolves images, such as multi-platform builder and run
+// images, to the image of a single platform.
+type PlatformResolver struct {
+	KeychainFactory KeychainFactory
+}
+
+// Resolve returns the digest reference of the image built for the platform.
+// An image index is resolved to its child of the platform, any other image
+// must have been built for the platform.
+func (r *PlatformResolver) Resolve(image, platform string, secretRef SecretRef) (string, error) {
+	os, arch, variant, err := v1alpha1.ParsePlatform(platform)
+	if err != nil {
+		return "", err
+	}
+
+	keychain, err := r.KeychainFactory.KeychainForSecretRef(secretRef)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := name.ParseReference(image, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	img, err := remote.Image(ref, remote.WithAuthFromKeychain(keychain), remote.WithPlatform(v1.Platform{
+		OS:           os,
+		Architecture: arch,
+		Variant:      variant,
+	}))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to resolve %s for platform %s", image, platform)
+	}
+
+	config, err := img.ConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	if config.OS != os || config.Architecture != arch {
+		return "", errors.Errorf("image %s is built for %s/%s instead of %s", image, config.OS, config.Architecture, platform)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s@%s", ref.Context().Name(), digest), nil
+}
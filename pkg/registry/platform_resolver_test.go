@@ -0,0 +1,75 @@
+package registry_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestPlatformResolver(t *testing.T) {
+	spec.Run(t, "Platform Resolver", testPlatformResolver)
+}
+
+func testPlatformResolver(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server          *httptest.Server
+		host            string
+		keychainFactory *fakeKeychainFactory
+		subject         *registry.PlatformResolver
+		secretRef       = registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewServer(ggcrregistry.New())
+		host = strings.TrimPrefix(server.URL, "http://")
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &registry.PlatformResolver{KeychainFactory: keychainFactory}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("resolves an image index to the image of the platform", func() {
+		amd64Digest := pushPlatformImage(t, host+"/builder:amd64", "linux", "amd64")
+		arm64Digest := pushPlatformImage(t, host+"/builder:arm64", "linux", "arm64")
+		_, err := (&registry.IndexWriter{KeychainFactory: keychainFactory}).Write([]string{host + "/builder:latest"}, []registry.PlatformImage{
+			{Platform: "linux/amd64", Image: host + "/builder@" + amd64Digest.String()},
+			{Platform: "linux/arm64", Image: host + "/builder@" + arm64Digest.String()},
+		}, secretRef)
+		require.NoError(t, err)
+
+		image, err := subject.Resolve(host+"/builder:latest", "linux/arm64", secretRef)
+		require.NoError(t, err)
+		assert.Equal(t, host+"/builder@"+arm64Digest.String(), image)
+		assert.Equal(t, secretRef, keychainFactory.secretRef)
+
+		_, err = subject.Resolve(host+"/builder:latest", "linux/s390x", secretRef)
+		require.Error(t, err)
+	})
+
+	it("resolves an image built for the platform to its digest", func() {
+		digest := pushPlatformImage(t, host+"/builder:arm64", "linux", "arm64")
+
+		image, err := subject.Resolve(host+"/builder:arm64", "linux/arm64", secretRef)
+		require.NoError(t, err)
+		assert.Equal(t, host+"/builder@"+digest.String(), image)
+	})
+
+	it("returns an error when the image is built for another platform", func() {
+		pushPlatformImage(t, host+"/builder:amd64", "linux", "amd64")
+
+		_, err := subject.Resolve(host+"/builder:amd64", "linux/arm64", secretRef)
+		require.EqualError(t, err, "image "+host+"/builder:amd64 is built for linux/amd64 instead of linux/arm64")
+	})
+}
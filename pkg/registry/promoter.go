@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+)
+
+// ImagePromoter copies an image by digest to another tag. The manifest is
+// copied unchanged so the promoted image has the same digest as the source.
+type ImagePromoter struct {
+	KeychainFactory KeychainFactory
+}
+
+func (p *ImagePromoter) Promote(source, tag string, secretRef SecretRef) (string, error) {
+	keychain, err := p.KeychainFactory.KeychainForSecretRef(secretRef)
+	if err != nil {
+		return "", err
+	}
+
+	sourceRef, err := name.NewDigest(source, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	targetRef, err := name.NewTag(tag, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	descriptor, err := remote.Get(sourceRef, remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to fetch %s", source)
+	}
+
+	// an image index is copied with its images instead of resolving it to
+	// the image of the default platform
+	if descriptor.MediaType == types.OCIImageIndex || descriptor.MediaType == types.DockerManifestList {
+		index, err := descriptor.ImageIndex()
+		if err != nil {
+			return "", err
+		}
+
+		if err := remote.WriteIndex(targetRef, index, remote.WithAuthFromKeychain(keychain)); err != nil {
+			return "", errors.Wrapf(err, "unable to write %s", tag)
+		}
+	} else {
+		image, err := descriptor.Image()
+		if err != nil {
+			return "", err
+		}
+
+		if err := remote.Write(targetRef, image, remote.WithAuthFromKeychain(keychain)); err != nil {
+			return "", errors.Wrapf(err, "unable to write %s", tag)
+		}
+	}
+
+	return targetRef.Context().Name() + "@" + descriptor.Digest.String(), nil
+}
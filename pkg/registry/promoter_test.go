@@ -0,0 +1,122 @@
+package registry_test
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+func TestImagePromoter(t *testing.T) {
+	spec.Run(t, "Image Promoter", testImagePromoter)
+}
+
+func testImagePromoter(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server          *httptest.Server
+		host            string
+		keychainFactory *fakeKeychainFactory
+		subject         *registry.ImagePromoter
+	)
+
+	it.Before(func() {
+		log.SetOutput(ioutil.Discard)
+		server = httptest.NewServer(ggcrregistry.New())
+		host = strings.TrimPrefix(server.URL, "http://")
+		keychainFactory = &fakeKeychainFactory{}
+		subject = &registry.ImagePromoter{KeychainFactory: keychainFactory}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("copies the image to the tag and returns the promoted digest", func() {
+		image, err := random.Image(10, 2)
+		require.NoError(t, err)
+		digest, err := image.Digest()
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(host+"/dev/app:latest", name.WeakValidation)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, image, remote.WithAuthFromKeychain(authn.DefaultKeychain)))
+
+		secretRef := registry.SecretRef{ServiceAccount: "some-sa", Namespace: "some-namespace"}
+		promoted, err := subject.Promote(host+"/dev/app@"+digest.String(), host+"/prod/app:v1", secretRef)
+		require.NoError(t, err)
+
+		assert.Equal(t, host+"/prod/app@"+digest.String(), promoted)
+		assert.Equal(t, secretRef, keychainFactory.secretRef)
+
+		promotedRef, err := name.ParseReference(host+"/prod/app:v1", name.WeakValidation)
+		require.NoError(t, err)
+		promotedImage, err := remote.Image(promotedRef, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+		require.NoError(t, err)
+		promotedDigest, err := promotedImage.Digest()
+		require.NoError(t, err)
+		assert.Equal(t, digest, promotedDigest)
+	})
+
+	it("copies an image index with its images unchanged", func() {
+		index, err := random.Index(10, 1, 2)
+		require.NoError(t, err)
+		digest, err := index.Digest()
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(host+"/dev/app:latest", name.WeakValidation)
+		require.NoError(t, err)
+		require.NoError(t, remote.WriteIndex(ref, index, remote.WithAuthFromKeychain(authn.DefaultKeychain)))
+
+		promoted, err := subject.Promote(host+"/dev/app@"+digest.String(), host+"/prod/app:v1", registry.SecretRef{})
+		require.NoError(t, err)
+		assert.Equal(t, host+"/prod/app@"+digest.String(), promoted)
+
+		promotedRef, err := name.ParseReference(host+"/prod/app:v1", name.WeakValidation)
+		require.NoError(t, err)
+		promotedIndex, err := remote.Index(promotedRef, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+		require.NoError(t, err)
+		promotedDigest, err := promotedIndex.Digest()
+		require.NoError(t, err)
+		assert.Equal(t, digest, promotedDigest)
+
+		manifest, err := index.IndexManifest()
+		require.NoError(t, err)
+		for _, child := range manifest.Manifests {
+			childRef, err := name.NewDigest(host+"/prod/app@"+child.Digest.String(), name.WeakValidation)
+			require.NoError(t, err)
+			_, err = remote.Image(childRef, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+			require.NoError(t, err)
+		}
+	})
+
+	it("returns an error when the source is not a digest", func() {
+		_, err := subject.Promote(host+"/dev/app:latest", host+"/prod/app:v1", registry.SecretRef{})
+		require.Error(t, err)
+	})
+
+	it("returns an error when the source does not exist", func() {
+		_, err := subject.Promote(host+"/dev/app@sha256:0000000000000000000000000000000000000000000000000000000000000000", host+"/prod/app:v1", registry.SecretRef{})
+		require.Error(t, err)
+	})
+}
+
+type fakeKeychainFactory struct {
+	secretRef registry.SecretRef
+}
+
+func (f *fakeKeychainFactory) KeychainForSecretRef(ref registry.SecretRef) (authn.Keychain, error) {
+	f.secretRef = ref
+	return authn.DefaultKeychain, nil
+}